@@ -0,0 +1,2 @@
+data "prowlarr_development_config" "example" {
+}
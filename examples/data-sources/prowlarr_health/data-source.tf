@@ -0,0 +1,10 @@
+data "prowlarr_health" "example" {
+  minimum_severity = "warning"
+}
+
+check "prowlarr_healthy" {
+  assert {
+    condition     = length([for c in data.prowlarr_health.example.checks : c if c.type == "error"]) == 0
+    error_message = "Prowlarr reports health errors."
+  }
+}
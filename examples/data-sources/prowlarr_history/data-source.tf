@@ -0,0 +1,8 @@
+data "prowlarr_history" "example" {
+  event_types = ["releaseGrabbed"]
+  indexer_ids = [1]
+  successful  = true
+  start_date  = "2024-01-01T00:00:00Z"
+  end_date    = "2024-02-01T00:00:00Z"
+  limit       = 500
+}
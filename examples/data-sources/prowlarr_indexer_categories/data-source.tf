@@ -0,0 +1,2 @@
+data "prowlarr_indexer_categories" "example" {
+}
@@ -0,0 +1,5 @@
+data "prowlarr_indexer_stats" "example" {
+  start_date  = "2024-01-01T00:00:00Z"
+  end_date    = "2024-02-01T00:00:00Z"
+  indexer_ids = [1]
+}
@@ -0,0 +1,3 @@
+data "prowlarr_indexer_status" "example" {
+  indexer_ids = [1, 2]
+}
@@ -0,0 +1,14 @@
+data "prowlarr_search" "example" {
+  query       = "ubuntu"
+  type        = "search"
+  categories  = [8000]
+  indexer_ids = [prowlarr_indexer.example.id]
+  limit       = 10
+}
+
+check "indexer_results" {
+  assert {
+    condition     = length(data.prowlarr_search.example.releases) > 0
+    error_message = "Indexer returned no results."
+  }
+}
@@ -0,0 +1,2 @@
+data "prowlarr_system_tasks" "example" {
+}
@@ -0,0 +1,14 @@
+ephemeral "prowlarr_api_key" "example" {
+}
+
+resource "prowlarr_application" "example" {
+  name               = "Example"
+  sync_level         = "disabled"
+  implementation     = "Lidarr"
+  config_contract    = "LidarrSettings"
+  base_url           = "http://localhost:8686"
+  prowlarr_url       = "http://localhost:9696"
+  api_key_wo         = ephemeral.prowlarr_api_key.example.api_key
+  api_key_wo_version = 1
+  sync_categories    = [3000, 3010, 3030]
+}
@@ -0,0 +1,8 @@
+resource "prowlarr_application_sonarr" "example" {
+  name            = "Example"
+  sync_level      = "disabled"
+  base_url        = "http://localhost:8989"
+  prowlarr_url    = "http://localhost:9696"
+  api_key         = "APIKey"
+  sync_categories = [provider::prowlarr::category_id("TV/SD"), provider::prowlarr::category_id("TV/HD")]
+}
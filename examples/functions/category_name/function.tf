@@ -0,0 +1,3 @@
+output "category" {
+  value = provider::prowlarr::category_name(5040)
+}
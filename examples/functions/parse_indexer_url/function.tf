@@ -0,0 +1,3 @@
+output "indexer_id" {
+  value = provider::prowlarr::parse_indexer_url("http://localhost:9696/1/api").indexer_id
+}
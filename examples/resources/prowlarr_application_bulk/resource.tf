@@ -0,0 +1,6 @@
+resource "prowlarr_application_bulk" "example" {
+  ids        = [prowlarr_application_sonarr.example.id, prowlarr_application_radarr.example.id]
+  sync_level = "fullSync"
+  tags       = [prowlarr_tag.example.id]
+  apply_tags = "replace"
+}
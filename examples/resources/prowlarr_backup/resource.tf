@@ -0,0 +1,13 @@
+# create a manual backup
+resource "prowlarr_backup" "example" {
+}
+
+# restore an existing backup
+resource "prowlarr_backup" "restore" {
+  restore_from_name = "prowlarr_backup_v1.20.0.4590_2024.06.01_10.00.00.zip"
+}
+
+# upload and restore a local backup archive
+resource "prowlarr_backup" "upload" {
+  restore_from_file = "/backups/prowlarr_backup_v1.20.0.4590_2024.06.01_10.00.00.zip"
+}
@@ -0,0 +1,8 @@
+resource "prowlarr_command" "example" {
+  name = "ApplicationIndexerSync"
+  body = jsonencode({ forceSync = true })
+
+  triggers = {
+    applications = join(",", [prowlarr_application_sonarr.example.id])
+  }
+}
@@ -0,0 +1,7 @@
+resource "prowlarr_development_config" "example" {
+  console_log_level    = "debug"
+  log_sql              = false
+  log_indexer_response = false
+  log_rotate           = 50
+  filter_sentry_events = true
+}
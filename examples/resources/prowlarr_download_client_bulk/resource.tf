@@ -0,0 +1,7 @@
+resource "prowlarr_download_client_bulk" "example" {
+  ids        = [1, 2]
+  enable     = true
+  priority   = 1
+  tags       = [prowlarr_tag.example.id]
+  apply_tags = "add"
+}
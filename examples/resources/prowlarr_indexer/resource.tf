@@ -14,8 +14,8 @@ resource "prowlarr_indexer" "example" {
       text_value = "test"
     },
     {
-      name       = "apiKey"
-      text_value = "test"
+      name            = "apiKey"
+      sensitive_value = "test"
     },
     {
       name      = "codecs"
@@ -0,0 +1,9 @@
+resource "prowlarr_indexer_bulk" "example" {
+  ids            = [1, 2, 3]
+  app_profile_id = 1
+  priority       = 10
+  tags           = [prowlarr_tag.example.id]
+  apply_tags     = "add"
+  seed_ratio     = 2
+  seed_time      = 60
+}
@@ -0,0 +1,14 @@
+resource "prowlarr_indexer_newznab" "example" {
+  enable         = true
+  name           = "Example"
+  app_profile_id = 1
+  priority       = 25
+  tags           = [1]
+
+  base_url    = "https://nzb.example.com"
+  api_path    = "/api"
+  api_key     = "APIKey"
+  query_limit = 100
+  grab_limit  = 10
+  limits_unit = 0
+}
\ No newline at end of file
@@ -0,0 +1,14 @@
+resource "prowlarr_indexer_torznab" "example" {
+  enable         = true
+  name           = "Example"
+  app_profile_id = 1
+  priority       = 25
+  tags           = [1]
+
+  base_url        = "https://torznab.example.com"
+  api_path        = "/api"
+  api_key         = "APIKey"
+  minimum_seeders = 1
+  seed_ratio      = 1.5
+  seed_time       = 1440
+}
\ No newline at end of file
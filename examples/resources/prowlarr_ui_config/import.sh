@@ -0,0 +1,2 @@
+# import does not need parameters
+terraform import prowlarr_ui_config.example ""
\ No newline at end of file
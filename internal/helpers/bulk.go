@@ -0,0 +1,63 @@
+package helpers
+
+import (
+	"slices"
+)
+
+// Apply tags modes for bulk edits.
+const (
+	ApplyTagsAdd     = "add"
+	ApplyTagsRemove  = "remove"
+	ApplyTagsReplace = "replace"
+)
+
+// BulkValue returns the desired value if every actual value matches it,
+// otherwise the first drifted value, so that the drift shows up in the plan.
+func BulkValue[T comparable](desired T, actuals []T) T {
+	for _, actual := range actuals {
+		if actual != desired {
+			return actual
+		}
+	}
+
+	return desired
+}
+
+// BulkTags returns the tags that are effectively applied according to the apply mode.
+// For add, the desired tags missing on any object are dropped.
+// For remove, the desired tags still present on any object are dropped.
+// For replace, the tags of the first object not matching exactly are returned.
+func BulkTags(desired []int32, actuals [][]int32, mode string) []int32 {
+	switch mode {
+	case ApplyTagsRemove:
+		return slices.DeleteFunc(slices.Clone(desired), func(tag int32) bool {
+			return slices.ContainsFunc(actuals, func(tags []int32) bool { return slices.Contains(tags, tag) })
+		})
+	case ApplyTagsReplace:
+		for _, tags := range actuals {
+			if !sameTags(desired, tags) {
+				return tags
+			}
+		}
+
+		return desired
+	default:
+		return slices.DeleteFunc(slices.Clone(desired), func(tag int32) bool {
+			return slices.ContainsFunc(actuals, func(tags []int32) bool { return !slices.Contains(tags, tag) })
+		})
+	}
+}
+
+func sameTags(a, b []int32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for _, tag := range a {
+		if !slices.Contains(b, tag) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,71 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkValue(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 1, BulkValue(1, []int{1, 1}))
+	assert.Equal(t, 2, BulkValue(1, []int{1, 2, 3}))
+	assert.Equal(t, true, BulkValue(true, nil))
+}
+
+func TestBulkTags(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		desired  []int32
+		actuals  [][]int32
+		mode     string
+		expected []int32
+	}{
+		"add applied": {
+			desired:  []int32{1, 2},
+			actuals:  [][]int32{{1, 2, 3}, {2, 1}},
+			mode:     ApplyTagsAdd,
+			expected: []int32{1, 2},
+		},
+		"add drifted": {
+			desired:  []int32{1, 2},
+			actuals:  [][]int32{{1, 2}, {1}},
+			mode:     ApplyTagsAdd,
+			expected: []int32{1},
+		},
+		"remove applied": {
+			desired:  []int32{1},
+			actuals:  [][]int32{{2}, {}},
+			mode:     ApplyTagsRemove,
+			expected: []int32{1},
+		},
+		"remove drifted": {
+			desired:  []int32{1, 2},
+			actuals:  [][]int32{{2}, {}},
+			mode:     ApplyTagsRemove,
+			expected: []int32{1},
+		},
+		"replace applied": {
+			desired:  []int32{1, 2},
+			actuals:  [][]int32{{2, 1}, {1, 2}},
+			mode:     ApplyTagsReplace,
+			expected: []int32{1, 2},
+		},
+		"replace drifted": {
+			desired:  []int32{1, 2},
+			actuals:  [][]int32{{1, 2}, {1, 2, 3}},
+			mode:     ApplyTagsReplace,
+			expected: []int32{1, 2, 3},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, BulkTags(test.desired, test.actuals, test.mode))
+		})
+	}
+}
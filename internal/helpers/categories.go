@@ -0,0 +1,126 @@
+package helpers
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+)
+
+// newznabCategoriesJSON is a copy of the standard Newznab categories returned by Prowlarr (/api/v1/indexer/categories).
+//
+//go:embed newznab_categories.json
+var newznabCategoriesJSON []byte
+
+// define errors for category and indexer URL lookups.
+var (
+	ErrUnknownCategory   = errors.New("unknown category")
+	ErrInvalidIndexerURL = errors.New("indexer URL must end with /<indexer ID>/api (e.g. http://localhost:9696/1/api)")
+)
+
+// NewznabCategories returns the standard Newznab category tree, it does not need any API call.
+var NewznabCategories = sync.OnceValue(func() []prowlarr.IndexerCategory {
+	var categories []prowlarr.IndexerCategory
+	if err := json.Unmarshal(newznabCategoriesJSON, &categories); err != nil {
+		panic(err)
+	}
+
+	return categories
+})
+
+// FindCategoryByID returns the category with the given ID, sub categories included.
+func FindCategoryByID(categories []prowlarr.IndexerCategory, id int64) (*prowlarr.IndexerCategory, error) {
+	for i := range categories {
+		if int64(categories[i].GetId()) == id {
+			return &categories[i], nil
+		}
+
+		if category, err := FindCategoryByID(categories[i].SubCategories, id); err == nil {
+			return category, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %d", ErrUnknownCategory, id)
+}
+
+// FindCategoryByName returns the category with the given name (e.g. `TV/HD`), the match is case insensitive.
+func FindCategoryByName(categories []prowlarr.IndexerCategory, name string) (*prowlarr.IndexerCategory, error) {
+	if category := findCategoryByName(categories, name); category != nil {
+		return category, nil
+	}
+
+	if match, found := ClosestMatch(name, CategoryNames(categories)); found {
+		return nil, fmt.Errorf("%w: '%s', did you mean '%s'?", ErrUnknownCategory, name, match)
+	}
+
+	return nil, fmt.Errorf("%w: '%s'", ErrUnknownCategory, name)
+}
+
+func findCategoryByName(categories []prowlarr.IndexerCategory, name string) *prowlarr.IndexerCategory {
+	for i := range categories {
+		if strings.EqualFold(categories[i].GetName(), name) {
+			return &categories[i]
+		}
+
+		if category := findCategoryByName(categories[i].SubCategories, name); category != nil {
+			return category
+		}
+	}
+
+	return nil
+}
+
+// CategoryNames returns the names of all the categories, sub categories included.
+func CategoryNames(categories []prowlarr.IndexerCategory) []string {
+	names := make([]string, 0, len(categories))
+
+	for _, c := range categories {
+		names = append(names, c.GetName())
+		names = append(names, CategoryNames(c.SubCategories)...)
+	}
+
+	return names
+}
+
+// IndexerURL describes the parts of a Prowlarr indexer proxy URL.
+type IndexerURL struct {
+	ProwlarrURL string
+	APIPath     string
+	IndexerID   int64
+}
+
+// ParseIndexerURL splits an indexer proxy URL (e.g. http://localhost:9696/1/api) into its parts.
+func ParseIndexerURL(rawURL string) (*IndexerURL, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if parsed.Scheme == "" || parsed.Host == "" {
+		return nil, fmt.Errorf("%w, got '%s'", ErrInvalidIndexerURL, rawURL)
+	}
+
+	segments := strings.Split(strings.TrimSuffix(parsed.Path, "/"), "/")
+	if len(segments) < 3 || !strings.EqualFold(segments[len(segments)-1], "api") {
+		return nil, fmt.Errorf("%w, got '%s'", ErrInvalidIndexerURL, rawURL)
+	}
+
+	id, err := strconv.ParseInt(segments[len(segments)-2], 10, 64)
+	if err != nil || id <= 0 {
+		return nil, fmt.Errorf("%w, got '%s'", ErrInvalidIndexerURL, rawURL)
+	}
+
+	base := url.URL{Scheme: parsed.Scheme, Host: parsed.Host, Path: strings.Join(segments[:len(segments)-2], "/")}
+
+	return &IndexerURL{
+		ProwlarrURL: base.String(),
+		APIPath:     "/" + segments[len(segments)-1],
+		IndexerID:   id,
+	}, nil
+}
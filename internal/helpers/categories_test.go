@@ -0,0 +1,149 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewznabCategories(t *testing.T) {
+	t.Parallel()
+
+	categories := NewznabCategories()
+	assert.Len(t, categories, 8)
+	assert.Equal(t, "TV", categories[4].GetName())
+	assert.Len(t, categories[4].SubCategories, 10)
+}
+
+func TestFindCategoryByID(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		expected string
+		id       int64
+	}{
+		"parent": {
+			id:       5000,
+			expected: "TV",
+		},
+		"child": {
+			id:       5040,
+			expected: "TV/HD",
+		},
+		"unknown": {
+			id: 5001,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			category, err := FindCategoryByID(NewznabCategories(), test.id)
+			if test.expected == "" {
+				assert.ErrorIs(t, err, ErrUnknownCategory)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, category.GetName())
+		})
+	}
+}
+
+func TestFindCategoryByName(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		name     string
+		err      string
+		expected int32
+	}{
+		"parent": {
+			name:     "Movies",
+			expected: 2000,
+		},
+		"child": {
+			name:     "TV/HD",
+			expected: 5040,
+		},
+		"case insensitive": {
+			name:     "audio/audiobook",
+			expected: 3030,
+		},
+		"suggestion": {
+			name: "TV/HDD",
+			err:  "unknown category: 'TV/HDD', did you mean 'TV/HD'?",
+		},
+		"unknown": {
+			name: "Something",
+			err:  "unknown category: 'Something'",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			category, err := FindCategoryByName(NewznabCategories(), test.name)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, category.GetId())
+		})
+	}
+}
+
+func TestParseIndexerURL(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		expected *IndexerURL
+		url      string
+	}{
+		"api": {
+			url:      "http://localhost:9696/1/api",
+			expected: &IndexerURL{ProwlarrURL: "http://localhost:9696", APIPath: "/api", IndexerID: 1},
+		},
+		"url base": {
+			url:      "https://example.com/prowlarr/12/api/?t=caps",
+			expected: &IndexerURL{ProwlarrURL: "https://example.com/prowlarr", APIPath: "/api", IndexerID: 12},
+		},
+		"missing api": {
+			url: "http://localhost:9696/1",
+		},
+		"missing id": {
+			url: "http://localhost:9696/api",
+		},
+		"invalid id": {
+			url: "http://localhost:9696/test/api",
+		},
+		"missing scheme": {
+			url: "localhost:9696/1/api",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, err := ParseIndexerURL(test.url)
+			if test.expected == nil {
+				assert.ErrorIs(t, err, ErrInvalidIndexerURL)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, parsed)
+		})
+	}
+}
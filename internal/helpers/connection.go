@@ -0,0 +1,159 @@
+package helpers
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// UnixScheme is the URL scheme used to connect through a Unix socket.
+const UnixScheme = "unix"
+
+// define errors for connection configuration.
+var (
+	ErrInvalidHeaderLine = errors.New("invalid header line, expected NAME=value")
+	ErrMissingURL        = errors.New("URL must be set")
+	ErrMissingURLScheme  = errors.New("URL must include the protocol (e.g. http://localhost:9696)")
+	ErrMissingURLHost    = errors.New("URL must include the host (e.g. http://localhost:9696)")
+	ErrMissingURLSocket  = errors.New("unix URL must include the socket path (e.g. unix:///run/prowlarr.sock)")
+	ErrURLAPIPath        = errors.New("URL must not include the API path (/api), the provider adds it automatically")
+)
+
+// ParseURL parses and validates the Prowlarr URL.
+// The returned warning is not empty when plain HTTP is used for a non local host.
+func ParseURL(rawURL string) (*url.URL, string, error) {
+	if rawURL == "" {
+		return nil, "", ErrMissingURL
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, "", err
+	}
+
+	switch {
+	case parsed.Scheme == "" || parsed.Opaque != "":
+		// host:port without protocol is parsed as an opaque URL
+		return nil, "", ErrMissingURLScheme
+	case parsed.Scheme == UnixScheme:
+		if parsed.Path == "" {
+			return nil, "", ErrMissingURLSocket
+		}
+
+		return parsed, "", nil
+	case parsed.Host == "":
+		return nil, "", ErrMissingURLHost
+	case isAPIPath(parsed.Path):
+		return nil, "", ErrURLAPIPath
+	case parsed.Scheme == "http" && !isLocalHost(parsed.Hostname()):
+		return parsed, fmt.Sprintf("The API key is sent in clear text to %s, consider using https.", parsed.Host), nil
+	default:
+		return parsed, "", nil
+	}
+}
+
+func isAPIPath(urlPath string) bool {
+	for _, segment := range strings.Split(urlPath, "/") {
+		if strings.EqualFold(segment, "api") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isLocalHost reports whether the host is a loopback, private or single label name (e.g. a container name).
+func isLocalHost(host string) bool {
+	if ip := net.ParseIP(host); ip != nil {
+		return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast()
+	}
+
+	return host == "localhost" || !strings.Contains(host, ".") || strings.HasSuffix(host, ".local") || strings.HasSuffix(host, ".localhost")
+}
+
+// VerifyConnection calls the system status endpoint and explains the failure in the diagnostics.
+func VerifyConnection(auth context.Context, client *prowlarr.APIClient, diags *diag.Diagnostics) *prowlarr.SystemResource {
+	status, httpResp, err := client.SystemAPI.GetSystemStatus(auth).Execute()
+
+	switch {
+	case err == nil:
+		return status
+	case httpResp == nil:
+		diags.AddError("Unable to connect to Prowlarr",
+			fmt.Sprintf("Network failure, check the URL and that Prowlarr is running and reachable.\nDetails: %s", err))
+	case httpResp.StatusCode == http.StatusUnauthorized:
+		diags.AddError("Invalid Prowlarr API key",
+			"Prowlarr rejected the API key (401 Unauthorized), check the api_key attribute or the PROWLARR_API_KEY environment variable.")
+	case httpResp.StatusCode == http.StatusNotFound || strings.Contains(httpResp.Header.Get("Content-Type"), "text/html"):
+		diags.AddError("Invalid Prowlarr URL",
+			fmt.Sprintf("The URL does not point to the Prowlarr API (%s), check the URL base configured in Prowlarr and any reverse proxy authentication page.", httpResp.Status))
+	default:
+		diags.AddError(ClientError, ParseClientError(Read, "system status", err))
+	}
+
+	return nil
+}
+
+// ReadHeadersFile reads headers from an env file with a NAME=value pair per line.
+// Empty lines, comments and the export keyword are ignored, values can be quoted
+// and names can use the PROWLARR_EXTRA_HEADER_ prefix of the environment variables.
+func ReadHeadersFile(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	headers := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		name, value, found := strings.Cut(strings.TrimPrefix(text, "export "), "=")
+		name = strings.TrimPrefix(strings.TrimSpace(name), "PROWLARR_EXTRA_HEADER_")
+
+		if !found || name == "" {
+			return nil, fmt.Errorf("%w: %s line %d", ErrInvalidHeaderLine, path, line)
+		}
+
+		headers[name] = unquote(strings.TrimSpace(value))
+	}
+
+	return headers, scanner.Err()
+}
+
+func unquote(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+
+	return value
+}
+
+// BasicAuthHeader returns the Authorization header value for basic authentication.
+func BasicAuthHeader(username, password string) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+}
+
+// UnixSocketDialContext returns a dialer connecting to the socket whatever the requested address.
+func UnixSocketDialContext(socket string) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, _, _ string) (net.Conn, error) {
+		var dialer net.Dialer
+
+		return dialer.DialContext(ctx, "unix", socket)
+	}
+}
@@ -0,0 +1,245 @@
+package helpers
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseURL(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		err     error
+		url     string
+		warning bool
+	}{
+		"https": {
+			url: "https://prowlarr.example.com",
+		},
+		"http local": {
+			url: "http://localhost:9696",
+		},
+		"http private": {
+			url: "http://192.168.1.10:9696/prowlarr",
+		},
+		"http container": {
+			url: "http://prowlarr:9696",
+		},
+		"http remote": {
+			url:     "http://prowlarr.example.com:9696",
+			warning: true,
+		},
+		"unix": {
+			url: "unix:///run/prowlarr.sock",
+		},
+		"empty": {
+			url: "",
+			err: ErrMissingURL,
+		},
+		"missing scheme": {
+			url: "localhost:9696",
+			err: ErrMissingURLScheme,
+		},
+		"missing scheme and port": {
+			url: "prowlarr.example.com",
+			err: ErrMissingURLScheme,
+		},
+		"missing host": {
+			url: "http:///prowlarr",
+			err: ErrMissingURLHost,
+		},
+		"missing socket": {
+			url: "unix://",
+			err: ErrMissingURLSocket,
+		},
+		"api path": {
+			url: "http://localhost:9696/prowlarr/api/v1",
+			err: ErrURLAPIPath,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, warning, err := ParseURL(test.url)
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.warning, warning != "")
+			assert.Equal(t, test.err == nil, parsed != nil)
+		})
+	}
+}
+
+func TestVerifyConnection(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		contentType string
+		body        string
+		summary     string
+		status      int
+	}{
+		"success": {
+			status:      http.StatusOK,
+			contentType: "application/json",
+			body:        `{"version":"1.20.0.4590"}`,
+		},
+		"unauthorized": {
+			status:  http.StatusUnauthorized,
+			summary: "Invalid Prowlarr API key",
+		},
+		"not found": {
+			status:  http.StatusNotFound,
+			summary: "Invalid Prowlarr URL",
+		},
+		"html": {
+			status:      http.StatusOK,
+			contentType: "text/html",
+			body:        "<html></html>",
+			summary:     "Invalid Prowlarr URL",
+		},
+		"server error": {
+			status:  http.StatusInternalServerError,
+			summary: ClientError,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v1/system/status", r.URL.Path)
+
+				if test.contentType != "" {
+					w.Header().Set("Content-Type", test.contentType)
+				}
+
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			var diags diag.Diagnostics
+
+			status := VerifyConnection(testServerContext(t, server.URL), prowlarr.NewAPIClient(prowlarr.NewConfiguration()), &diags)
+
+			if test.summary == "" {
+				assert.False(t, diags.HasError())
+				assert.Equal(t, "1.20.0.4590", status.GetVersion())
+
+				return
+			}
+
+			assert.Nil(t, status)
+			assert.Equal(t, test.summary, diags.Errors()[0].Summary())
+		})
+	}
+}
+
+func TestVerifyConnectionNetworkFailure(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {}))
+	server.Close()
+
+	var diags diag.Diagnostics
+
+	assert.Nil(t, VerifyConnection(testServerContext(t, server.URL), prowlarr.NewAPIClient(prowlarr.NewConfiguration()), &diags))
+	assert.Equal(t, "Unable to connect to Prowlarr", diags.Errors()[0].Summary())
+}
+
+func TestReadHeadersFile(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		expected map[string]string
+		content  string
+		err      bool
+	}{
+		"headers": {
+			content: "# comment\n\nX-Token=abc\nexport Remote-User = admin\nPROWLARR_EXTRA_HEADER_X-Env=\"quoted=value\"\nX-Empty=\n",
+			expected: map[string]string{
+				"X-Token":     "abc",
+				"Remote-User": "admin",
+				"X-Env":       "quoted=value",
+				"X-Empty":     "",
+			},
+		},
+		"missing separator": {
+			content: "X-Token\n",
+			err:     true,
+		},
+		"missing name": {
+			content: "=value\n",
+			err:     true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "headers.env")
+			require.NoError(t, os.WriteFile(path, []byte(test.content), 0o600))
+
+			headers, err := ReadHeadersFile(path)
+			assert.Equal(t, test.err, err != nil)
+			assert.Equal(t, test.expected, headers)
+		})
+	}
+}
+
+func TestReadHeadersFileMissing(t *testing.T) {
+	t.Parallel()
+
+	_, err := ReadHeadersFile(filepath.Join(t.TempDir(), "missing.env"))
+	assert.Error(t, err)
+}
+
+func TestBasicAuthHeader(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "Basic dXNlcjpwYXNz", BasicAuthHeader("user", "pass"))
+}
+
+func TestUnixSocketDialContext(t *testing.T) {
+	t.Parallel()
+
+	// keep the path short to fit the socket name length limit
+	dir, err := os.MkdirTemp("", "prowlarr")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	socket := filepath.Join(dir, "api.sock")
+
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.URL.Path))
+	}))
+	server.Listener = listener
+	server.Start()
+	defer server.Close()
+
+	client := &http.Client{Transport: &http.Transport{DialContext: UnixSocketDialContext(socket)}}
+
+	resp, err := client.Get("http://localhost/api/v1/system/status")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
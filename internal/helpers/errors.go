@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"fmt"
+	"net/http"
 
 	"github.com/devopsarr/prowlarr-go/prowlarr"
 )
@@ -13,12 +14,14 @@ const (
 	Update                            = "update"
 	Delete                            = "delete"
 	List                              = "list"
+	Restore                           = "restore"
 	ClientError                       = "Client Error"
 	ResourceError                     = "Resource Error"
 	DataSourceError                   = "Data Source Error"
 	UnexpectedImportIdentifier        = "Unexpected Import Identifier"
 	UnexpectedResourceConfigureType   = "Unexpected Resource Configure Type"
 	UnexpectedDataSourceConfigureType = "Unexpected DataSource Configure Type"
+	UnknownInstance                   = "Unknown Prowlarr Instance"
 )
 
 func ParseNotFoundError(kind, field, search string) string {
@@ -32,3 +35,8 @@ func ParseClientError(action, name string, err error) string {
 
 	return fmt.Sprintf("Unable to %s %s, got error: %s", action, name, err)
 }
+
+// IsNotFound checks if the API response reports a missing object.
+func IsNotFound(httpResp *http.Response) bool {
+	return httpResp != nil && httpResp.StatusCode == http.StatusNotFound
+}
@@ -41,6 +41,38 @@ func getFieldExceptions() []fieldException {
 			apiName: "seedCriteria.seasonPackSeedTime",
 			tfName:  "seasonPackSeedTime",
 		},
+		{
+			apiName: "baseSettings.queryLimit",
+			tfName:  "queryLimit",
+		},
+		{
+			apiName: "baseSettings.grabLimit",
+			tfName:  "grabLimit",
+		},
+		{
+			apiName: "baseSettings.limitsUnit",
+			tfName:  "limitsUnit",
+		},
+		{
+			apiName: "torrentBaseSettings.seedRatio",
+			tfName:  "torrentSeedRatio",
+		},
+		{
+			apiName: "torrentBaseSettings.seedTime",
+			tfName:  "torrentSeedTime",
+		},
+		{
+			apiName: "torrentBaseSettings.packSeedTime",
+			tfName:  "torrentPackSeedTime",
+		},
+		{
+			apiName: "torrentBaseSettings.appMinimumSeeders",
+			tfName:  "minimumSeeders",
+		},
+		{
+			apiName: "torrentBaseSettings.preferMagnetUrl",
+			tfName:  "torrentPreferMagnetURL",
+		},
 	}
 }
 
@@ -334,3 +366,33 @@ func WriteFields(ctx context.Context, fieldContainer interface{}, fields []prowl
 		}
 	}
 }
+
+// SetWriteOnlyFields sets the known values into the API fields, adding the missing ones.
+// It is used for write only attributes, which are sent to the API but never stored in state.
+func SetWriteOnlyFields(fields []prowlarr.Field, values map[string]types.String) []prowlarr.Field {
+	// Sort names to keep the fields order stable.
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		value := values[name]
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		index := slices.IndexFunc(fields, func(f prowlarr.Field) bool { return f.GetName() == name })
+		if index < 0 {
+			fields = append(fields, setField(name, value.ValueString()))
+
+			continue
+		}
+
+		fields[index].SetValue(value.ValueString())
+	}
+
+	return fields
+}
@@ -11,12 +11,14 @@ import (
 )
 
 type Test struct {
-	Fl       types.Float64
-	Set      types.Set
-	Str      types.String
-	In       types.Int64
-	SeedTime types.Int64
-	Boo      types.Bool
+	Fl             types.Float64
+	Set            types.Set
+	Str            types.String
+	In             types.Int64
+	SeedTime       types.Int64
+	QueryLimit     types.Int64
+	MinimumSeeders types.Int64
+	Boo            types.Bool
 }
 
 func TestWriteStringField(t *testing.T) {
@@ -122,6 +124,12 @@ func TestWriteIntField(t *testing.T) {
 			written:  Test{},
 			expected: Test{SeedTime: types.Int64Value(50)},
 		},
+		"minimumseeders": {
+			name:     "torrentBaseSettings.appMinimumSeeders",
+			value:    &value,
+			written:  Test{},
+			expected: Test{MinimumSeeders: types.Int64Value(50)},
+		},
 		"nil": {
 			name:     "in",
 			value:    nil,
@@ -316,6 +324,22 @@ func TestReadIntField(t *testing.T) {
 			tfName: "seedTime",
 			value:  10,
 		},
+		"querylimit": {
+			fieldCase: Test{
+				QueryLimit: types.Int64Value(5),
+			},
+			name:   "baseSettings.queryLimit",
+			tfName: "queryLimit",
+			value:  5,
+		},
+		"minimumseeders": {
+			fieldCase: Test{
+				MinimumSeeders: types.Int64Value(1),
+			},
+			name:   "torrentBaseSettings.appMinimumSeeders",
+			tfName: "minimumSeeders",
+			value:  1,
+		},
 	}
 	for name, test := range tests {
 		test := test
@@ -653,3 +677,35 @@ func TestWriteFields(t *testing.T) {
 		})
 	}
 }
+
+func TestSetWriteOnlyFields(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		values   map[string]types.String
+		expected []prowlarr.Field
+	}{
+		"override": {
+			values:   map[string]types.String{"password": types.StringValue("secret")},
+			expected: []prowlarr.Field{setField("host", "localhost"), setField("password", "secret")},
+		},
+		"add": {
+			values:   map[string]types.String{"apiKey": types.StringValue("key")},
+			expected: []prowlarr.Field{setField("host", "localhost"), setField("password", SensitiveValue), setField("apiKey", "key")},
+		},
+		"null": {
+			values:   map[string]types.String{"password": types.StringNull(), "apiKey": types.StringUnknown()},
+			expected: []prowlarr.Field{setField("host", "localhost"), setField("password", SensitiveValue)},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			fields := []prowlarr.Field{setField("host", "localhost"), setField("password", SensitiveValue)}
+			assert.Equal(t, test.expected, SetWriteOnlyFields(fields, test.values))
+		})
+	}
+}
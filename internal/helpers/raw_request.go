@@ -0,0 +1,82 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+)
+
+// ErrUnexpectedStatus is returned by RawRequest when the API does not answer with a success status.
+var ErrUnexpectedStatus = errors.New("unexpected status")
+
+// authContextKeys are the client configuration values stored in the authentication context.
+var authContextKeys = []any{
+	prowlarr.ContextAPIKeys,
+	prowlarr.ContextServerIndex,
+	prowlarr.ContextOperationServerIndices,
+	prowlarr.ContextServerVariables,
+	prowlarr.ContextOperationServerVariables,
+}
+
+// RawRequest calls the API directly for the payloads the generated client cannot build,
+// reusing the client configuration and the authentication stored in the auth context.
+// The request runs on ctx, so that it is canceled along with the Terraform operation.
+// The operation is the generated client operation name, used to select the server URL.
+func RawRequest(ctx, auth context.Context, client *prowlarr.APIClient, operation, method, apiPath, contentType string, body io.Reader) ([]byte, error) {
+	config := client.GetConfig()
+
+	for _, key := range authContextKeys {
+		if value := auth.Value(key); value != nil {
+			ctx = context.WithValue(ctx, key, value)
+		}
+	}
+
+	baseURL, err := config.ServerURLWithContext(ctx, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, baseURL+apiPath, body)
+	if err != nil {
+		return nil, err
+	}
+
+	for header, value := range config.DefaultHeader {
+		request.Header.Set(header, value)
+	}
+
+	if keys, ok := ctx.Value(prowlarr.ContextAPIKeys).(map[string]prowlarr.APIKey); ok {
+		for header, key := range keys {
+			request.Header.Set(header, key.Key)
+		}
+	}
+
+	request.Header.Set("Content-Type", contentType)
+	request.Header.Set("Accept", "application/json")
+
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	content, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode >= http.StatusMultipleChoices {
+		return content, fmt.Errorf("%w: %s\nDetails:\n%s", ErrUnexpectedStatus, response.Status, content)
+	}
+
+	return content, nil
+}
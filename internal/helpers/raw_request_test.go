@@ -0,0 +1,74 @@
+package helpers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRawRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status   int
+		err      bool
+		canceled bool
+	}{
+		"success": {
+			status: http.StatusCreated,
+		},
+		"failure": {
+			status: http.StatusBadRequest,
+			err:    true,
+		},
+		"canceled": {
+			status:   http.StatusCreated,
+			err:      true,
+			canceled: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				assert.Equal(t, "/api/v1/command", r.URL.Path)
+				assert.Equal(t, "key", r.Header.Get("X-Api-Key"))
+				assert.Equal(t, "value", r.Header.Get("X-Extra"))
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				w.WriteHeader(test.status)
+				_, _ = w.Write(body)
+			}))
+			defer server.Close()
+
+			auth := context.WithValue(testServerContext(t, server.URL), prowlarr.ContextAPIKeys, map[string]prowlarr.APIKey{
+				"X-Api-Key": {Key: "key"},
+			})
+			config := prowlarr.NewConfiguration()
+			config.AddDefaultHeader("X-Extra", "value")
+
+			// the request runs on the operation context, with the authentication of the auth one
+			ctx, cancel := context.WithCancel(context.Background())
+			if test.canceled {
+				cancel()
+			}
+			defer cancel()
+
+			content, err := RawRequest(ctx, auth, prowlarr.NewAPIClient(config), "CommandAPIService.CreateCommand", http.MethodPost, "/api/v1/command", "application/json", strings.NewReader(`{"name":"test"}`))
+			assert.Equal(t, test.err, err != nil)
+
+			if !test.canceled {
+				assert.Equal(t, `{"name":"test"}`, string(content))
+			}
+		})
+	}
+}
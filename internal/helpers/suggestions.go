@@ -0,0 +1,59 @@
+package helpers
+
+import (
+	"strings"
+)
+
+// ClosestMatch returns the candidate most similar to the given value.
+// The match is case insensitive, it ignores the candidate prefix (e.g. `baseSettings.`)
+// and it is returned only if the edit distance is at most half of the value length.
+func ClosestMatch(value string, candidates []string) (string, bool) {
+	var (
+		match string
+		found bool
+	)
+
+	best := len(value)/2 + 1
+
+	for _, c := range candidates {
+		name := strings.ToLower(c)
+		d := min(levenshtein(strings.ToLower(value), name), levenshtein(strings.ToLower(value), name[strings.LastIndex(name, ".")+1:]))
+
+		if d < best {
+			best = d
+			match = c
+			found = true
+		}
+	}
+
+	return match, found
+}
+
+// levenshtein computes the edit distance between two strings.
+func levenshtein(a, b string) int {
+	first, second := []rune(a), []rune(b)
+	row := make([]int, len(second)+1)
+
+	for j := range row {
+		row[j] = j
+	}
+
+	for i := 1; i <= len(first); i++ {
+		previous := row[0]
+		row[0] = i
+
+		for j := 1; j <= len(second); j++ {
+			current := row[j]
+			cost := 1
+
+			if first[i-1] == second[j-1] {
+				cost = 0
+			}
+
+			row[j] = min(row[j]+1, row[j-1]+1, previous+cost)
+			previous = current
+		}
+	}
+
+	return row[len(second)]
+}
@@ -0,0 +1,85 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClosestMatch(t *testing.T) {
+	t.Parallel()
+
+	candidates := []string{"baseUrl", "username", "password", "baseSettings.queryLimit"}
+
+	tests := map[string]struct {
+		value    string
+		expected string
+		found    bool
+	}{
+		"typo": {
+			value:    "usernme",
+			expected: "username",
+			found:    true,
+		},
+		"case": {
+			value:    "baseURL",
+			expected: "baseUrl",
+			found:    true,
+		},
+		"prefix": {
+			value:    "queryLimit",
+			expected: "baseSettings.queryLimit",
+			found:    true,
+		},
+		"missing": {
+			value:    "cookie",
+			expected: "",
+			found:    false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, found := ClosestMatch(test.value, candidates)
+			assert.Equal(t, test.expected, match)
+			assert.Equal(t, test.found, found)
+		})
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		a        string
+		b        string
+		expected int
+	}{
+		"equal": {
+			a:        "apiKey",
+			b:        "apiKey",
+			expected: 0,
+		},
+		"empty": {
+			a:        "",
+			b:        "apiKey",
+			expected: 6,
+		},
+		"substitution": {
+			a:        "kitten",
+			b:        "sitting",
+			expected: 3,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, levenshtein(test.a, test.b))
+		})
+	}
+}
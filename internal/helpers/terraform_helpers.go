@@ -3,10 +3,16 @@ package helpers
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // ImportStatePassthroughIntID is a helper function to set the import
@@ -32,3 +38,56 @@ func ImportStatePassthroughIntID(ctx context.Context, attrPath path.Path, req re
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, id)...)
 }
+
+// HandleReadError manages the errors returned while reading a resource.
+// If the object is not found anymore, the resource is removed from state
+// so that it can be planned for recreation, otherwise a client error is raised.
+func HandleReadError(ctx context.Context, name string, err error, httpResp *http.Response, resp *resource.ReadResponse) {
+	if IsNotFound(httpResp) {
+		tflog.Warn(ctx, name+" not found, removing it from state")
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.AddError(ClientError, ParseClientError(Read, name, err))
+}
+
+// ParseTimeAttribute parses an optional RFC3339 string attribute.
+// The returned flag is true only if the attribute is set and valid.
+func ParseTimeAttribute(value types.String, attrPath path.Path, diags *diag.Diagnostics) (time.Time, bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return time.Time{}, false
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(attrPath, DataSourceError, fmt.Sprintf("Expected RFC3339 timestamp (e.g. `2024-01-02T15:04:05Z`). Got: %s", value.ValueString()))
+
+		return time.Time{}, false
+	}
+
+	return parsed, true
+}
+
+// TimeValue converts an optional time into a RFC3339 string value.
+func TimeValue(t *time.Time) types.String {
+	if t == nil || t.IsZero() {
+		return types.StringNull()
+	}
+
+	return types.StringValue(t.Format(time.RFC3339))
+}
+
+// JoinIDs converts a set of IDs into the comma separated list used by the API filters.
+func JoinIDs(ctx context.Context, set types.Set, diags *diag.Diagnostics) string {
+	ids := make([]int64, len(set.Elements()))
+	diags.Append(set.ElementsAs(ctx, &ids, true)...)
+
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = strconv.FormatInt(id, 10)
+	}
+
+	return strings.Join(values, ",")
+}
@@ -0,0 +1,176 @@
+package helpers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleReadError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status  int
+		removed bool
+	}{
+		"not found": {
+			status:  http.StatusNotFound,
+			removed: true,
+		},
+		"unauthorized": {
+			status:  http.StatusUnauthorized,
+			removed: false,
+		},
+		"server error": {
+			status:  http.StatusInternalServerError,
+			removed: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(test.status)
+			}))
+			defer server.Close()
+
+			ctx := testServerContext(t, server.URL)
+			client := prowlarr.NewAPIClient(prowlarr.NewConfiguration())
+			_, httpResp, err := client.TagAPI.GetTagById(ctx, 1).Execute()
+			assert.Error(t, err)
+
+			resp := testReadResponse()
+			HandleReadError(context.Background(), "tag", err, httpResp, resp)
+
+			assert.Equal(t, test.removed, resp.State.Raw.IsNull())
+			assert.Equal(t, !test.removed, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestHandleReadErrorNetwork(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {}))
+	ctx := testServerContext(t, server.URL)
+	server.Close()
+
+	client := prowlarr.NewAPIClient(prowlarr.NewConfiguration())
+	_, httpResp, err := client.TagAPI.GetTagById(ctx, 1).Execute()
+	assert.Error(t, err)
+
+	resp := testReadResponse()
+	HandleReadError(context.Background(), "tag", err, httpResp, resp)
+
+	assert.False(t, resp.State.Raw.IsNull())
+	assert.True(t, resp.Diagnostics.HasError())
+}
+
+func TestParseTimeAttribute(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		expected time.Time
+		value    types.String
+		ok       bool
+		err      bool
+	}{
+		"valid": {
+			value:    types.StringValue("2024-01-02T15:04:05Z"),
+			expected: time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC),
+			ok:       true,
+		},
+		"null": {
+			value: types.StringNull(),
+		},
+		"invalid": {
+			value: types.StringValue("2024-01-02"),
+			err:   true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			parsed, ok := ParseTimeAttribute(test.value, path.Root("start_date"), &diags)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.err, diags.HasError())
+			assert.True(t, test.expected.Equal(parsed))
+		})
+	}
+}
+
+func TestTimeValue(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	assert.Equal(t, types.StringValue("2024-01-02T15:04:05Z"), TimeValue(&date))
+	assert.Equal(t, types.StringNull(), TimeValue(&time.Time{}))
+	assert.Equal(t, types.StringNull(), TimeValue(nil))
+}
+
+func TestJoinIDs(t *testing.T) {
+	t.Parallel()
+
+	var diags diag.Diagnostics
+
+	set := types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1)})
+
+	assert.Equal(t, "1", JoinIDs(context.Background(), set, &diags))
+	assert.Equal(t, "", JoinIDs(context.Background(), types.SetNull(types.Int64Type), &diags))
+	assert.False(t, diags.HasError())
+}
+
+// testServerContext returns a context pointing the prowlarr client to the given server.
+func testServerContext(t *testing.T, serverURL string) context.Context {
+	t.Helper()
+
+	parsed, err := url.Parse(serverURL)
+	assert.NoError(t, err)
+
+	return context.WithValue(context.Background(), prowlarr.ContextServerVariables, map[string]string{
+		"protocol": parsed.Scheme,
+		"hostpath": parsed.Host,
+	})
+}
+
+// testReadResponse returns a read response with a populated state.
+func testReadResponse() *resource.ReadResponse {
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.Number}}
+
+	return &resource.ReadResponse{
+		State: tfsdk.State{
+			Schema: testSchema,
+			Raw:    tftypes.NewValue(objectType, map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.Number, 1)}),
+		},
+	}
+}
@@ -0,0 +1,77 @@
+package helpers
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// define errors for TLS configuration.
+var (
+	ErrInvalidCACertificate    = errors.New("cannot parse CA certificate: no valid PEM certificate found")
+	ErrIncompleteClientKeyPair = errors.New("client certificate and client key must be set together")
+)
+
+// TLSConfig builds the client TLS configuration.
+// Certificates and key can be passed either as PEM content or as file paths.
+func TLSConfig(caCertificate, clientCertificate, clientKey string, insecureSkipVerify bool) (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: insecureSkipVerify,
+	}
+
+	if caCertificate != "" {
+		ca, err := PEMOrFile(caCertificate)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read CA certificate: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, ErrInvalidCACertificate
+		}
+
+		config.RootCAs = pool
+	}
+
+	if (clientCertificate == "") != (clientKey == "") {
+		return nil, ErrIncompleteClientKeyPair
+	}
+
+	if clientCertificate != "" {
+		cert, err := PEMOrFile(clientCertificate)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read client certificate: %w", err)
+		}
+
+		key, err := PEMOrFile(clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read client key: %w", err)
+		}
+
+		pair, err := tls.X509KeyPair(cert, key)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse client certificate: %w", err)
+		}
+
+		config.Certificates = []tls.Certificate{pair}
+	}
+
+	return config, nil
+}
+
+// PEMOrFile returns the value itself if it is PEM encoded, the content of the file it points to otherwise.
+func PEMOrFile(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+
+	return os.ReadFile(value)
+}
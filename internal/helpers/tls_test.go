@@ -0,0 +1,155 @@
+package helpers
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	clientCert, clientKey := testKeyPair(t)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	server.TLS = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ClientAuth: tls.RequestClientCert,
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	ca := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, []byte(ca), 0o600))
+
+	tests := map[string]struct {
+		ca       string
+		cert     string
+		key      string
+		status   int
+		insecure bool
+		err      bool
+	}{
+		"ca pem": {
+			ca:     ca,
+			status: http.StatusUnauthorized,
+		},
+		"ca file": {
+			ca:     caFile,
+			status: http.StatusUnauthorized,
+		},
+		"client certificate": {
+			ca:     ca,
+			cert:   clientCert,
+			key:    clientKey,
+			status: http.StatusOK,
+		},
+		"insecure": {
+			insecure: true,
+			status:   http.StatusUnauthorized,
+		},
+		"unknown authority": {
+			err: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config, err := TLSConfig(test.ca, test.cert, test.key, test.insecure)
+			require.NoError(t, err)
+
+			client := &http.Client{Transport: &http.Transport{TLSClientConfig: config}}
+
+			resp, err := client.Get(server.URL)
+			assert.Equal(t, test.err, err != nil)
+
+			if err == nil {
+				resp.Body.Close()
+				assert.Equal(t, test.status, resp.StatusCode)
+			}
+		})
+	}
+}
+
+func TestTLSConfigError(t *testing.T) {
+	t.Parallel()
+
+	cert, _ := testKeyPair(t)
+	_, otherKey := testKeyPair(t)
+
+	tests := map[string]struct {
+		ca   string
+		cert string
+		key  string
+	}{
+		"missing ca file": {
+			ca: filepath.Join(t.TempDir(), "missing.pem"),
+		},
+		"invalid ca": {
+			ca: "-----BEGIN CERTIFICATE-----\ninvalid\n-----END CERTIFICATE-----",
+		},
+		"missing key": {
+			cert: cert,
+		},
+		"mismatched key pair": {
+			cert: cert,
+			key:  otherKey,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := TLSConfig(test.ca, test.cert, test.key, false)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func testKeyPair(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}))
+}
@@ -0,0 +1,243 @@
+package helpers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RetryTransport is an http.RoundTripper retrying failed calls with exponential backoff
+// and limiting the number of requests sent per second.
+// Network errors, 502 and 504 responses are retried only for idempotent methods,
+// while 429 and 503 responses are retried for every method since the server did not process them.
+type RetryTransport struct {
+	// Base is the wrapped transport. If nil, http.DefaultTransport is used.
+	Base    http.RoundTripper
+	limiter *rateLimiter
+	// MaxRetries is the number of retries after the first attempt.
+	MaxRetries int
+	// WaitMin is the wait before the first retry, doubled at every attempt.
+	WaitMin time.Duration
+	// WaitMax caps both the backoff and the Retry-After header.
+	WaitMax time.Duration
+	// Timeout limits every single attempt. Zero means no timeout.
+	Timeout time.Duration
+}
+
+// NewRetryTransport returns a RetryTransport wrapping base.
+// A requestsPerSecond lower or equal to zero disables rate limiting.
+func NewRetryTransport(base http.RoundTripper, maxRetries int, waitMin, waitMax, timeout time.Duration, requestsPerSecond float64) *RetryTransport {
+	transport := &RetryTransport{
+		Base:       base,
+		MaxRetries: maxRetries,
+		WaitMin:    waitMin,
+		WaitMax:    waitMax,
+		Timeout:    timeout,
+	}
+
+	if requestsPerSecond > 0 {
+		transport.limiter = &rateLimiter{interval: time.Duration(float64(time.Second) / requestsPerSecond)}
+	}
+
+	return transport
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	for attempt := 0; ; attempt++ {
+		if err := t.limiter.wait(req.Context()); err != nil {
+			return nil, err
+		}
+
+		resp, err := t.attempt(base, req)
+		if attempt >= t.MaxRetries || !t.shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		retry, ok := rewindBody(req)
+		if !ok {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+
+		if resp != nil {
+			// drain the body to reuse the connection
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		req = retry
+	}
+}
+
+// attempt sends the request once, applying the timeout until the response body is closed.
+func (t *RetryTransport) attempt(base http.RoundTripper, req *http.Request) (*http.Response, error) {
+	if t.Timeout <= 0 {
+		return base.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.Timeout)
+
+	resp, err := base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+
+		return nil, err
+	}
+
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
+}
+
+func (t *RetryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		return req.Context().Err() == nil && isIdempotent(req.Method)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return isIdempotent(req.Method)
+	default:
+		return false
+	}
+}
+
+// backoff returns the Retry-After value if present, the exponential backoff otherwise, capped to WaitMax.
+func (t *RetryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	wait := t.WaitMin << attempt
+	if wait < t.WaitMin {
+		// overflow
+		wait = t.WaitMax
+	}
+
+	if resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			wait = retryAfter
+		}
+	}
+
+	if t.WaitMax > 0 && wait > t.WaitMax {
+		wait = t.WaitMax
+	}
+
+	return wait
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// rewindBody returns a copy of the request with a fresh body to be retried, or false if not possible.
+func rewindBody(req *http.Request) (*http.Request, bool) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, true
+	}
+
+	if req.GetBody == nil {
+		return nil, false
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, false
+	}
+
+	retry := req.Clone(req.Context())
+	retry.Body = body
+
+	return retry, true
+}
+
+// cancelBody releases the attempt context when the body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+
+	return err
+}
+
+// rateLimiter spaces requests by a fixed interval.
+type rateLimiter struct {
+	next     time.Time
+	interval time.Duration
+	mu       sync.Mutex
+}
+
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+
+	slot := l.next
+	if slot.Before(now) {
+		slot = now
+	}
+
+	l.next = slot.Add(l.interval)
+	l.mu.Unlock()
+
+	delay := time.Until(slot)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
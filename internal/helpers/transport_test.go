@@ -0,0 +1,271 @@
+package helpers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryTransport(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method   string
+		statuses []int
+		status   int
+		calls    int32
+	}{
+		"success": {
+			method:   http.MethodGet,
+			statuses: []int{http.StatusOK},
+			status:   http.StatusOK,
+			calls:    1,
+		},
+		"retry get on bad gateway": {
+			method:   http.MethodGet,
+			statuses: []int{http.StatusBadGateway, http.StatusGatewayTimeout, http.StatusOK},
+			status:   http.StatusOK,
+			calls:    3,
+		},
+		"retry post on service unavailable": {
+			method:   http.MethodPost,
+			statuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusCreated},
+			status:   http.StatusCreated,
+			calls:    3,
+		},
+		"no retry post on bad gateway": {
+			method:   http.MethodPost,
+			statuses: []int{http.StatusBadGateway, http.StatusOK},
+			status:   http.StatusBadGateway,
+			calls:    1,
+		},
+		"no retry on internal error": {
+			method:   http.MethodPut,
+			statuses: []int{http.StatusInternalServerError, http.StatusOK},
+			status:   http.StatusInternalServerError,
+			calls:    1,
+		},
+		"max retries": {
+			method:   http.MethodDelete,
+			statuses: []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+			status:   http.StatusServiceUnavailable,
+			calls:    3,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				assert.Equal(t, "payload", string(body))
+
+				call := atomic.AddInt32(&calls, 1)
+				w.WriteHeader(test.statuses[call-1])
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: NewRetryTransport(nil, 2, time.Millisecond, 10*time.Millisecond, 0, 0)}
+
+			req, err := http.NewRequest(test.method, server.URL, strings.NewReader("payload"))
+			assert.NoError(t, err)
+
+			resp, err := client.Do(req)
+			assert.NoError(t, err)
+			resp.Body.Close()
+
+			assert.Equal(t, test.status, resp.StatusCode)
+			assert.Equal(t, test.calls, atomic.LoadInt32(&calls))
+		})
+	}
+}
+
+func TestRetryTransportRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	var (
+		calls int32
+		first time.Time
+		delay time.Duration
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			first = time.Now()
+
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+
+			return
+		}
+
+		delay = time.Since(first)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewRetryTransport(nil, 1, time.Millisecond, 5*time.Second, 0, 0)}
+
+	resp, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	assert.GreaterOrEqual(t, delay, time.Second)
+}
+
+func TestRetryTransportNetworkError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	var calls int32
+
+	base := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&calls, 1)
+
+		return http.DefaultTransport.RoundTrip(req)
+	})
+
+	client := &http.Client{Transport: NewRetryTransport(base, 2, time.Millisecond, time.Millisecond, 0, 0)}
+
+	_, err := client.Get(url)
+	assert.Error(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+
+	atomic.StoreInt32(&calls, 0)
+
+	_, err = client.Post(url, "text/plain", strings.NewReader("payload"))
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestRetryTransportContextCancel(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	client := &http.Client{Transport: NewRetryTransport(nil, 5, time.Minute, time.Minute, 0, 0)}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	assert.NoError(t, err)
+
+	start := time.Now()
+	_, err = client.Do(req)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Minute)
+}
+
+func TestRetryTransportTimeout(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+
+			return
+		}
+
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewRetryTransport(nil, 1, time.Millisecond, time.Millisecond, 100*time.Millisecond, 0)}
+
+	resp, err := client.Get(server.URL)
+	assert.NoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", string(body))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestRetryTransportRateLimit(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewRetryTransport(nil, 0, 0, 0, 0, 20)}
+
+	start := time.Now()
+
+	for range 5 {
+		resp, err := client.Get(server.URL)
+		assert.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	// 5 requests at 20 per second need at least 4 intervals of 50ms
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value string
+		wait  time.Duration
+		ok    bool
+	}{
+		"empty": {
+			value: "",
+		},
+		"seconds": {
+			value: "3",
+			wait:  3 * time.Second,
+			ok:    true,
+		},
+		"past date": {
+			value: "Wed, 21 Oct 2015 07:28:00 GMT",
+			ok:    true,
+		},
+		"invalid": {
+			value: "soon",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			wait, ok := parseRetryAfter(test.value)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.wait, wait)
+		})
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
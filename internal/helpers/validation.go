@@ -0,0 +1,146 @@
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	TestAction      = "test"
+	ValidationError = "Validation Error"
+)
+
+// ValidationFailure describes a single validation failure returned by Prowlarr.
+type ValidationFailure struct {
+	PropertyName string `json:"propertyName"`
+	ErrorMessage string `json:"errorMessage"`
+	IsWarning    bool   `json:"isWarning"`
+}
+
+// ParseValidationFailures extracts the validation failures from the body of a Prowlarr error.
+func ParseValidationFailures(err error) []ValidationFailure {
+	e, ok := err.(*prowlarr.GenericOpenAPIError)
+	if !ok {
+		return nil
+	}
+
+	var failures []ValidationFailure
+	if json.Unmarshal(e.Body(), &failures) != nil {
+		return nil
+	}
+
+	return failures
+}
+
+// HandleTestError converts the validation failures returned by a test call into diagnostics.
+// Each failure points to the matching attribute of the plan schema when it exists,
+// to the matching element of the generic `fields` attribute otherwise, falling back to a resource level diagnostic.
+// Warnings do not add any error, so callers must go on with the apply unless diags.HasError().
+func HandleTestError(ctx context.Context, name string, err error, plan tfsdk.Plan, diags *diag.Diagnostics) {
+	failures := ParseValidationFailures(err)
+	if len(failures) == 0 {
+		diags.AddError(ClientError, ParseClientError(TestAction, name, err))
+
+		return
+	}
+
+	for _, f := range failures {
+		attrPath, detail := validationFailurePath(ctx, f, plan)
+
+		switch {
+		case attrPath.Equal(path.Empty()) && f.IsWarning:
+			diags.AddWarning(ValidationError, detail)
+		case attrPath.Equal(path.Empty()):
+			diags.AddError(ValidationError, detail)
+		case f.IsWarning:
+			diags.AddAttributeWarning(attrPath, ValidationError, detail)
+		default:
+			diags.AddAttributeError(attrPath, ValidationError, detail)
+		}
+	}
+}
+
+// validationFailurePath identifies the attribute path and the detail message for a validation failure.
+func validationFailurePath(ctx context.Context, failure ValidationFailure, plan tfsdk.Plan) (path.Path, string) {
+	property := failure.PropertyName[strings.LastIndex(failure.PropertyName, ".")+1:]
+	attrPath := path.Root(ToSnakeCase(property))
+
+	if property != "" {
+		if _, d := plan.Schema.AttributeAtPath(ctx, attrPath); !d.HasError() {
+			return attrPath, failure.ErrorMessage
+		}
+	}
+
+	detail := failure.ErrorMessage
+	if failure.PropertyName != "" {
+		detail = fmt.Sprintf("Field '%s': %s", toCamelCase(failure.PropertyName), failure.ErrorMessage)
+	}
+
+	if _, d := plan.Schema.AttributeAtPath(ctx, path.Root("fields")); !d.HasError() {
+		return fieldPath(ctx, plan, toCamelCase(failure.PropertyName)), detail
+	}
+
+	return path.Empty(), detail
+}
+
+// fieldPath returns the path of the `fields` element with the given name, the whole attribute if none matches.
+func fieldPath(ctx context.Context, plan tfsdk.Plan, name string) path.Path {
+	fieldsPath := path.Root("fields")
+
+	var fields types.Set
+	if plan.GetAttribute(ctx, fieldsPath, &fields).HasError() {
+		return fieldsPath
+	}
+
+	for _, element := range fields.Elements() {
+		object, ok := element.(types.Object)
+		if !ok {
+			continue
+		}
+
+		if fieldName, ok := object.Attributes()["name"].(types.String); ok && strings.EqualFold(fieldName.ValueString(), name) {
+			return fieldsPath.AtSetValue(element)
+		}
+	}
+
+	return fieldsPath
+}
+
+// ToSnakeCase converts a Prowlarr property name (e.g. `ApiKey`) into a terraform attribute name (e.g. `api_key`).
+func ToSnakeCase(name string) string {
+	runes := []rune(name)
+
+	var output strings.Builder
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 &&
+			(!unicode.IsUpper(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			output.WriteRune('_')
+		}
+
+		output.WriteRune(unicode.ToLower(r))
+	}
+
+	return output.String()
+}
+
+// toCamelCase converts a Prowlarr property name (e.g. `BaseSettings.QueryLimit`) into a field name (e.g. `baseSettings.queryLimit`).
+func toCamelCase(name string) string {
+	parts := strings.Split(name, ".")
+	for i, p := range parts {
+		if p != "" {
+			parts[i] = strings.ToLower(p[:1]) + p[1:]
+		}
+	}
+
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,188 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToSnakeCase(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		name     string
+		expected string
+	}{
+		"pascal": {
+			name:     "ApiKey",
+			expected: "api_key",
+		},
+		"camel": {
+			name:     "baseUrl",
+			expected: "base_url",
+		},
+		"acronym": {
+			name:     "URLBase",
+			expected: "url_base",
+		},
+		"single": {
+			name:     "Host",
+			expected: "host",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, ToSnakeCase(test.name))
+		})
+	}
+}
+
+func TestHandleTestError(t *testing.T) {
+	t.Parallel()
+
+	typedPlan := tfsdk.Plan{Schema: schema.Schema{Attributes: map[string]schema.Attribute{
+		"api_key":     schema.StringAttribute{Optional: true},
+		"query_limit": schema.Int64Attribute{Optional: true},
+	}}}
+	genericPlan := tfsdk.Plan{Schema: schema.Schema{Attributes: map[string]schema.Attribute{
+		"fields": schema.SetAttribute{Optional: true},
+	}}}
+	fieldsPlan, fieldElement := testFieldsPlan(t)
+
+	tests := map[string]struct {
+		plan     tfsdk.Plan
+		body     string
+		detail   string
+		path     path.Path
+		expected int
+		warning  bool
+	}{
+		"typed": {
+			body:     `[{"propertyName":"ApiKey","errorMessage":"Invalid API Key","isWarning":false}]`,
+			plan:     typedPlan,
+			path:     path.Root("api_key"),
+			detail:   "Invalid API Key",
+			expected: 1,
+		},
+		"nested": {
+			body:     `[{"propertyName":"BaseSettings.QueryLimit","errorMessage":"Must be positive","isWarning":true}]`,
+			plan:     typedPlan,
+			path:     path.Root("query_limit"),
+			detail:   "Must be positive",
+			warning:  true,
+			expected: 1,
+		},
+		"generic": {
+			body:     `[{"propertyName":"BaseSettings.QueryLimit","errorMessage":"Must be positive","isWarning":false}]`,
+			plan:     genericPlan,
+			path:     path.Root("fields"),
+			detail:   "Field 'baseSettings.queryLimit': Must be positive",
+			expected: 1,
+		},
+		"field": {
+			body:     `[{"propertyName":"BaseUrl","errorMessage":"Unable to connect","isWarning":false}]`,
+			plan:     fieldsPlan,
+			path:     path.Root("fields").AtSetValue(fieldElement),
+			detail:   "Field 'baseUrl': Unable to connect",
+			expected: 1,
+		},
+		"missing field": {
+			body:     `[{"propertyName":"ApiKey","errorMessage":"Invalid API Key","isWarning":false}]`,
+			plan:     fieldsPlan,
+			path:     path.Root("fields"),
+			detail:   "Field 'apiKey': Invalid API Key",
+			expected: 1,
+		},
+		"resource": {
+			body:     `[{"propertyName":"","errorMessage":"Unable to connect","isWarning":false}]`,
+			plan:     typedPlan,
+			path:     path.Empty(),
+			detail:   "Unable to connect",
+			expected: 1,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			client := prowlarr.NewAPIClient(prowlarr.NewConfiguration())
+			_, err := client.IndexerAPI.TestIndexer(testServerContext(t, server.URL)).IndexerResource(*prowlarr.NewIndexerResource()).Execute()
+			assert.Error(t, err)
+
+			resp := testReadResponse()
+			HandleTestError(context.Background(), "indexer", err, test.plan, &resp.Diagnostics)
+
+			assert.Len(t, resp.Diagnostics, test.expected)
+			assert.Equal(t, test.detail, resp.Diagnostics[0].Detail())
+			assert.Equal(t, test.warning, resp.Diagnostics.WarningsCount() == 1)
+
+			if withPath, ok := resp.Diagnostics[0].(interface{ Path() path.Path }); ok {
+				assert.Equal(t, test.path, withPath.Path())
+			} else {
+				assert.Equal(t, path.Empty(), test.path)
+			}
+		})
+	}
+}
+
+func TestHandleTestErrorGeneric(t *testing.T) {
+	t.Parallel()
+
+	resp := testReadResponse()
+	HandleTestError(context.Background(), "indexer", errors.New("other error"), tfsdk.Plan{}, &resp.Diagnostics)
+
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, ClientError, resp.Diagnostics[0].Summary())
+}
+
+// testFieldsPlan returns a plan with a generic fields set holding a baseUrl field, and the field element.
+func testFieldsPlan(t *testing.T) (tfsdk.Plan, attr.Value) {
+	t.Helper()
+
+	ctx := context.Background()
+	plan := tfsdk.Plan{Schema: schema.Schema{Attributes: map[string]schema.Attribute{
+		"fields": schema.SetNestedAttribute{
+			Optional: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name":       schema.StringAttribute{Required: true},
+					"text_value": schema.StringAttribute{Optional: true},
+				},
+			},
+		},
+	}}}
+
+	elementType := map[string]attr.Type{"name": types.StringType, "text_value": types.StringType}
+	element := types.ObjectValueMust(elementType, map[string]attr.Value{
+		"name":       types.StringValue("baseUrl"),
+		"text_value": types.StringValue("https://0magnet.co/"),
+	})
+
+	plan.Raw = tftypes.NewValue(plan.Schema.Type().TerraformType(ctx), nil)
+	assert.False(t, plan.SetAttribute(ctx, path.Root("fields"), types.SetValueMust(types.ObjectType{AttrTypes: elementType}, []attr.Value{element})).HasError())
+
+	return plan, element
+}
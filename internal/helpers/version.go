@@ -0,0 +1,57 @@
+package helpers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// UnsupportedVersion is the diagnostic summary for resources not supported by the Prowlarr version.
+	UnsupportedVersion = "Unsupported Prowlarr Version"
+	// UnknownVersion is the diagnostic summary when the Prowlarr version cannot be read to check it.
+	UnknownVersion = "Unknown Prowlarr Version"
+)
+
+// CompareVersions compares two dot separated numeric versions (e.g. 1.20.0.4590),
+// returning -1, 0 or 1. Missing or non numeric segments count as zero.
+func CompareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+
+	for i := range max(len(as), len(bs)) {
+		if diff := versionSegment(as, i) - versionSegment(bs, i); diff != 0 {
+			if diff < 0 {
+				return -1
+			}
+
+			return 1
+		}
+	}
+
+	return 0
+}
+
+func versionSegment(segments []string, index int) int {
+	if index >= len(segments) {
+		return 0
+	}
+
+	value, _ := strconv.Atoi(segments[index])
+
+	return value
+}
+
+// CheckVersion returns a message explaining why the version is outside the minimum and maximum bounds,
+// or an empty string if supported. Empty bounds and an unknown version are ignored.
+func CheckVersion(name, version, minimum, maximum string) string {
+	switch {
+	case version == "":
+		return ""
+	case minimum != "" && CompareVersions(version, minimum) < 0:
+		return fmt.Sprintf("%s requires Prowlarr >= %s, but the server runs version %s.", name, minimum, version)
+	case maximum != "" && CompareVersions(version, maximum) > 0:
+		return fmt.Sprintf("%s requires Prowlarr <= %s, but the server runs version %s.", name, maximum, version)
+	default:
+		return ""
+	}
+}
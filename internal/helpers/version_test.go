@@ -0,0 +1,94 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareVersions(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		a        string
+		b        string
+		expected int
+	}{
+		"equal": {
+			a:        "1.20.0.4590",
+			b:        "1.20.0.4590",
+			expected: 0,
+		},
+		"missing segments": {
+			a:        "1.20",
+			b:        "1.20.0.0",
+			expected: 0,
+		},
+		"lower": {
+			a:        "1.9.4.4039",
+			b:        "1.20.0",
+			expected: -1,
+		},
+		"greater": {
+			a:        "2.0.0.1",
+			b:        "1.99",
+			expected: 1,
+		},
+		"build": {
+			a:        "1.20.0.4591",
+			b:        "1.20.0.4590",
+			expected: 1,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, CompareVersions(test.a, test.b))
+		})
+	}
+}
+
+func TestCheckVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		version  string
+		minimum  string
+		maximum  string
+		expected string
+	}{
+		"supported": {
+			version: "1.20.0.4590",
+			minimum: "1.1.0",
+			maximum: "2.0.0",
+		},
+		"unknown version": {
+			minimum: "1.1.0",
+		},
+		"no bounds": {
+			version: "0.1.0",
+		},
+		"too old": {
+			version:  "1.0.1.2210",
+			minimum:  "1.1.0",
+			expected: "test requires Prowlarr >= 1.1.0, but the server runs version 1.0.1.2210.",
+		},
+		"too new": {
+			version:  "2.0.1.5000",
+			maximum:  "2.0.0",
+			expected: "test requires Prowlarr <= 2.0.0, but the server runs version 2.0.1.5000.",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, CheckVersion("test", test.version, test.minimum, test.maximum))
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const apiKeyEphemeralResourceName = "api_key"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &APIKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &APIKeyEphemeralResource{}
+)
+
+func NewAPIKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &APIKeyEphemeralResource{}
+}
+
+// APIKeyEphemeralResource defines the API key implementation.
+type APIKeyEphemeralResource struct {
+	data *ProwlarrData
+}
+
+// APIKey describes the API key data model.
+type APIKey struct {
+	Instance types.String `tfsdk:"instance"`
+	APIKey   types.String `tfsdk:"api_key"`
+}
+
+func (e *APIKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + apiKeyEphemeralResourceName
+}
+
+func (e *APIKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->\nRead the API key from the [Host](../resources/host) configuration without storing it in state.\nRequires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"instance": schema.StringAttribute{
+				MarkdownDescription: instanceDescription,
+				Optional:            true,
+			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: "API key.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (e *APIKeyEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProwlarrData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			helpers.UnexpectedResourceConfigureType,
+			fmt.Sprintf("Expected *ProwlarrData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	e.data = data
+}
+
+func (e *APIKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var key *APIKey
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &key)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := e.data.instance(key.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	// Get host config current value
+	response, _, err := data.Client.HostConfigAPI.GetHostConfig(data.Auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, apiKeyEphemeralResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "opened "+apiKeyEphemeralResourceName)
+	key.write(response)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &key)...)
+}
+
+func (k *APIKey) write(config *prowlarr.HostConfigResource) {
+	k.APIKey = types.StringValue(config.GetApiKey())
+}
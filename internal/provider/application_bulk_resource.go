@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const applicationBulkResourceName = "application_bulk"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ApplicationBulkResource{}
+
+func NewApplicationBulkResource() resource.Resource {
+	return &ApplicationBulkResource{}
+}
+
+// ApplicationBulkResource defines the application bulk implementation.
+type ApplicationBulkResource struct {
+	data *ProwlarrData
+}
+
+// ApplicationBulk describes the application bulk data model.
+type ApplicationBulk struct {
+	Instance  types.String `tfsdk:"instance"`
+	IDs       types.Set    `tfsdk:"ids"`
+	Tags      types.Set    `tfsdk:"tags"`
+	ApplyTags types.String `tfsdk:"apply_tags"`
+	SyncLevel types.String `tfsdk:"sync_level"`
+	ID        types.String `tfsdk:"id"`
+}
+
+func (r *ApplicationBulkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + applicationBulkResourceName
+}
+
+func (r *ApplicationBulkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Applications -->\nApplication Bulk resource.\nApply the same partial update to a set of [Applications](../resources/application) in a single call. Only the configured attributes are managed and checked for drift.\nDestroying the resource leaves the applications as they are.\nFor more information refer to [Applications](https://wiki.servarr.com/prowlarr/settings#applications) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Application Bulk ID, the sorted list of application IDs.",
+				Computed:            true,
+			},
+			"ids": schema.SetAttribute{
+				MarkdownDescription: "Application IDs.",
+				Required:            true,
+				ElementType:         types.Int64Type,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"sync_level": schema.StringAttribute{
+				MarkdownDescription: "Sync level.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("addOnly", "disabled", "fullSync"),
+				},
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List of associated tags.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"apply_tags": schema.StringAttribute{
+				MarkdownDescription: "How tags are applied. Valid values are `add`, `remove` and `replace`. Defaults to `add`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(helpers.ApplyTagsAdd),
+				Validators: []validator.String{
+					stringvalidator.OneOf(helpers.ApplyTagsAdd, helpers.ApplyTagsRemove, helpers.ApplyTagsReplace),
+				},
+			},
+		},
+	}
+}
+
+func (r *ApplicationBulkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.data = resourceConfigure(ctx, req, resp)
+}
+
+func (r *ApplicationBulkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var bulk *ApplicationBulk
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &bulk)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.data.instance(bulk.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	r.apply(ctx, instance, bulk, helpers.Create, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created "+applicationBulkResourceName+": "+bulk.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &bulk)...)
+}
+
+func (r *ApplicationBulkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var bulk *ApplicationBulk
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &bulk)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.data.instance(bulk.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	// Get applications current value
+	response, _, err := instance.Client.ApplicationAPI.ListApplications(instance.Auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, applicationBulkResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+applicationBulkResourceName+": "+bulk.ID.ValueString())
+	// Map response body to resource schema attribute
+	found := bulk.write(ctx, response, &resp.Diagnostics)
+	// Objects deleted outside of Terraform are reported as drift on ids
+	bulk.IDs, bulk.ID = writeBulkIDs(ctx, found, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &bulk)...)
+}
+
+func (r *ApplicationBulkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var bulk *ApplicationBulk
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &bulk)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.data.instance(bulk.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	r.apply(ctx, instance, bulk, helpers.Update, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+applicationBulkResourceName+": "+bulk.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &bulk)...)
+}
+
+func (r *ApplicationBulkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var bulk *ApplicationBulk
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &bulk)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bulk edits cannot be reverted just removing them from state
+	tflog.Trace(ctx, "decoupled "+applicationBulkResourceName+": "+bulk.ID.ValueString())
+	resp.State.RemoveResource(ctx)
+}
+
+// apply sends the bulk edit and refreshes the given model.
+func (r *ApplicationBulkResource) apply(ctx context.Context, instance *ProwlarrData, bulk *ApplicationBulk, action string, diags *diag.Diagnostics) {
+	request := bulk.read(ctx, diags)
+	if diags.HasError() {
+		return
+	}
+
+	if _, _, err := instance.Client.ApplicationAPI.PutApplicationsBulk(instance.Auth).ApplicationBulkResource(*request).Execute(); err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, applicationBulkResourceName, err))
+
+		return
+	}
+
+	response, _, err := instance.Client.ApplicationAPI.ListApplications(instance.Auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, applicationBulkResourceName, err))
+
+		return
+	}
+
+	found := bulk.write(ctx, response, diags)
+	// The configured ids are kept in state, so missing objects fail the apply
+	checkBulkIDs(ctx, applicationResourceName, bulk.IDs, found, diags)
+	_, bulk.ID = writeBulkIDs(ctx, found, diags)
+}
+
+// write refreshes the managed attributes and returns the IDs of the existing objects.
+func (b *ApplicationBulk) write(ctx context.Context, applications []prowlarr.ApplicationResource, diags *diag.Diagnostics) []int32 {
+	ids := make([]int32, 0, len(b.IDs.Elements()))
+	diags.Append(b.IDs.ElementsAs(ctx, &ids, false)...)
+
+	found := make([]int32, 0, len(ids))
+	syncLevels := make([]string, 0, len(ids))
+	tags := make([][]int32, 0, len(ids))
+
+	// Only the applications in the bulk still existing are considered
+	for _, a := range applications {
+		if !slices.Contains(ids, a.GetId()) {
+			continue
+		}
+
+		var application Application
+
+		application.write(ctx, &a, diags)
+
+		applicationTags := make([]int32, 0, len(application.Tags.Elements()))
+		diags.Append(application.Tags.ElementsAs(ctx, &applicationTags, false)...)
+
+		found = append(found, int32(application.ID.ValueInt64()))
+		syncLevels = append(syncLevels, application.SyncLevel.ValueString())
+		tags = append(tags, applicationTags)
+	}
+
+	b.Tags = writeBulkTags(ctx, b.Tags, b.ApplyTags.ValueString(), tags, diags)
+
+	if !b.SyncLevel.IsNull() {
+		b.SyncLevel = types.StringValue(helpers.BulkValue(b.SyncLevel.ValueString(), syncLevels))
+	}
+
+	return found
+}
+
+func (b *ApplicationBulk) read(ctx context.Context, diags *diag.Diagnostics) *prowlarr.ApplicationBulkResource {
+	bulk := prowlarr.NewApplicationBulkResource()
+
+	ids := make([]int32, 0, len(b.IDs.Elements()))
+	diags.Append(b.IDs.ElementsAs(ctx, &ids, false)...)
+	bulk.SetIds(ids)
+
+	if !b.Tags.IsNull() {
+		tags := make([]int32, 0, len(b.Tags.Elements()))
+		diags.Append(b.Tags.ElementsAs(ctx, &tags, false)...)
+		bulk.SetTags(tags)
+		bulk.SetApplyTags(prowlarr.ApplyTags(b.ApplyTags.ValueString()))
+	}
+
+	if !b.SyncLevel.IsNull() {
+		bulk.SetSyncLevel(prowlarr.ApplicationSyncLevel(b.SyncLevel.ValueString()))
+	}
+
+	return bulk
+}
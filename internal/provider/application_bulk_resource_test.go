@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApplicationBulkResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccApplicationBulkResourceConfig("addOnly") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccApplicationBulkResourceConfig("addOnly"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_application_bulk.test", "sync_level", "addOnly"),
+					resource.TestCheckResourceAttrSet("prowlarr_application_bulk.test", "id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccApplicationBulkResourceConfig("addOnly") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccApplicationBulkResourceConfig("disabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_application_bulk.test", "sync_level", "disabled"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccApplicationBulkResourceConfig(syncLevel string) string {
+	return fmt.Sprintf(`
+	resource "prowlarr_tag" "bulk" {
+		label = "applicationbulk"
+	}
+
+	resource "prowlarr_application" "bulk" {
+		name = "BulkTest"
+		sync_level = "disabled"
+		implementation  = "Lidarr"
+		config_contract = "LidarrSettings"
+
+		base_url = "http://localhost:8686"
+		prowlarr_url = "http://localhost:9696"
+		api_key = "APIKey"
+		sync_categories = [3000, 3010, 3030]
+
+		lifecycle {
+			ignore_changes = [sync_level, tags]
+		}
+	}
+
+	resource "prowlarr_application_bulk" "test" {
+		ids = [prowlarr_application.bulk.id]
+		sync_level = "%s"
+		tags = [prowlarr_tag.bulk.id]
+		apply_tags = "replace"
+	}`, syncLevel)
+}
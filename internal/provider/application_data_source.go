@@ -5,10 +5,10 @@ import (
 
 	"github.com/devopsarr/prowlarr-go/prowlarr"
 	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
-
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +24,13 @@ func NewApplicationDataSource() datasource.DataSource {
 
 // ApplicationDataSource defines the application implementation.
 type ApplicationDataSource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
+}
+
+// InstanceApplication extends Application with the instance attribute, which the list elements do not have.
+type InstanceApplication struct {
+	Instance types.String `tfsdk:"instance"`
+	Application
 }
 
 func (d *ApplicationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -37,6 +42,7 @@ func (d *ApplicationDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 		// This description is used by the documentation generator and the delay server.
 		MarkdownDescription: "<!-- subcategory:Applications -->\nSingle [Application](../resources/application).",
 		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
 			"config_contract": schema.StringAttribute{
 				MarkdownDescription: "Application configuration template.",
 				Computed:            true,
@@ -91,22 +97,26 @@ func (d *ApplicationDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 }
 
 func (d *ApplicationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
-		d.client = client
-		d.auth = auth
-	}
+	d.data = dataSourceConfigure(ctx, req, resp)
 }
 
 func (d *ApplicationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data *Application
+	var data *InstanceApplication
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	instance, err := d.data.instance(data.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
 	// Get application current value
-	response, _, err := d.client.ApplicationAPI.ListApplications(d.auth).Execute()
+	response, _, err := instance.Client.ApplicationAPI.ListApplications(instance.Auth).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, applicationDataSourceName, err))
 
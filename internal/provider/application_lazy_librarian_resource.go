@@ -29,6 +29,7 @@ const (
 var (
 	_ resource.Resource                = &ApplicationLazyLibrarianResource{}
 	_ resource.ResourceWithImportState = &ApplicationLazyLibrarianResource{}
+	_ resource.ResourceWithModifyPlan  = &ApplicationLazyLibrarianResource{}
 )
 
 func NewApplicationLazyLibrarianResource() resource.Resource {
@@ -37,12 +38,12 @@ func NewApplicationLazyLibrarianResource() resource.Resource {
 
 // ApplicationLazyLibrarianResource defines the application implementation.
 type ApplicationLazyLibrarianResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // ApplicationLazyLibrarian describes the application data model.
 type ApplicationLazyLibrarian struct {
+	Instance       types.String `tfsdk:"instance"`
 	SyncCategories types.Set    `tfsdk:"sync_categories"`
 	Tags           types.Set    `tfsdk:"tags"`
 	Name           types.String `tfsdk:"name"`
@@ -51,6 +52,7 @@ type ApplicationLazyLibrarian struct {
 	BaseURL        types.String `tfsdk:"base_url"`
 	APIKey         types.String `tfsdk:"api_key"`
 	ID             types.Int64  `tfsdk:"id"`
+	TestOnApply    types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (a ApplicationLazyLibrarian) toApplication() *Application {
@@ -87,6 +89,11 @@ func (r *ApplicationLazyLibrarianResource) Schema(_ context.Context, _ resource.
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Applications -->\nApplication LazyLibrarian resource.\nFor more information refer to [Application](https://wiki.servarr.com/prowlarr/settings#applications) and [LazyLibrarian](https://wiki.servarr.com/prowlarr/supported#lazylibrarian).",
 		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Test the configuration against Prowlarr before applying it.",
+				Optional:            true,
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Application name.",
 				Required:            true,
@@ -136,10 +143,7 @@ func (r *ApplicationLazyLibrarianResource) Schema(_ context.Context, _ resource.
 }
 
 func (r *ApplicationLazyLibrarianResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
-	}
+	r.data = resourceConfigure(ctx, req, resp)
 }
 
 func (r *ApplicationLazyLibrarianResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -152,10 +156,27 @@ func (r *ApplicationLazyLibrarianResource) Create(ctx context.Context, req resou
 		return
 	}
 
+	instance, err := r.data.instance(application.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Create new ApplicationLazyLibrarian
 	request := application.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ApplicationAPI.CreateApplications(r.auth).ApplicationResource(*request).Execute()
+	if application.TestOnApply.ValueBool() {
+		if _, err = instance.Client.ApplicationAPI.TestApplications(instance.Auth).ApplicationResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, applicationLazyLibrarianResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.ApplicationAPI.CreateApplications(instance.Auth).ApplicationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, applicationLazyLibrarianResourceName, err))
 
@@ -178,10 +199,17 @@ func (r *ApplicationLazyLibrarianResource) Read(ctx context.Context, req resourc
 		return
 	}
 
+	instance, err := r.data.instance(application.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get ApplicationLazyLibrarian current value
-	response, _, err := r.client.ApplicationAPI.GetApplicationsById(r.auth, int32(application.ID.ValueInt64())).Execute()
+	response, httpResp, err := instance.Client.ApplicationAPI.GetApplicationsById(instance.Auth, int32(application.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, applicationLazyLibrarianResourceName, err))
+		helpers.HandleReadError(ctx, applicationLazyLibrarianResourceName, err, httpResp, resp)
 
 		return
 	}
@@ -202,10 +230,27 @@ func (r *ApplicationLazyLibrarianResource) Update(ctx context.Context, req resou
 		return
 	}
 
+	instance, err := r.data.instance(application.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Update ApplicationLazyLibrarian
 	request := application.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ApplicationAPI.UpdateApplications(r.auth, strconv.Itoa(int(request.GetId()))).ApplicationResource(*request).Execute()
+	if application.TestOnApply.ValueBool() {
+		if _, err = instance.Client.ApplicationAPI.TestApplications(instance.Auth).ApplicationResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, applicationLazyLibrarianResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.ApplicationAPI.UpdateApplications(instance.Auth, strconv.Itoa(int(request.GetId()))).ApplicationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, applicationLazyLibrarianResourceName, err))
 
@@ -219,16 +264,27 @@ func (r *ApplicationLazyLibrarianResource) Update(ctx context.Context, req resou
 }
 
 func (r *ApplicationLazyLibrarianResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID   int64
+		name types.String
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	instance, err := r.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Delete ApplicationLazyLibrarian current value
-	_, err := r.client.ApplicationAPI.DeleteApplications(r.auth, int32(ID)).Execute()
+	_, err = instance.Client.ApplicationAPI.DeleteApplications(instance.Auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, applicationLazyLibrarianResourceName, err))
 
@@ -239,7 +295,12 @@ func (r *ApplicationLazyLibrarianResource) Delete(ctx context.Context, req resou
 	resp.State.RemoveResource(ctx)
 }
 
+func (r *ApplicationLazyLibrarianResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateApplicationCategories(ctx, r.data, req, resp)
+}
+
 func (r *ApplicationLazyLibrarianResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importInstance(ctx, r.data, req.ID, resp)
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
 	tflog.Trace(ctx, "imported "+applicationLazyLibrarianResourceName+": "+req.ID)
 }
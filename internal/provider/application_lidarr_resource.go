@@ -29,6 +29,7 @@ const (
 var (
 	_ resource.Resource                = &ApplicationLidarrResource{}
 	_ resource.ResourceWithImportState = &ApplicationLidarrResource{}
+	_ resource.ResourceWithModifyPlan  = &ApplicationLidarrResource{}
 )
 
 func NewApplicationLidarrResource() resource.Resource {
@@ -37,12 +38,12 @@ func NewApplicationLidarrResource() resource.Resource {
 
 // ApplicationLidarrResource defines the application implementation.
 type ApplicationLidarrResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // ApplicationLidarr describes the application data model.
 type ApplicationLidarr struct {
+	Instance       types.String `tfsdk:"instance"`
 	SyncCategories types.Set    `tfsdk:"sync_categories"`
 	Tags           types.Set    `tfsdk:"tags"`
 	Name           types.String `tfsdk:"name"`
@@ -51,6 +52,7 @@ type ApplicationLidarr struct {
 	BaseURL        types.String `tfsdk:"base_url"`
 	APIKey         types.String `tfsdk:"api_key"`
 	ID             types.Int64  `tfsdk:"id"`
+	TestOnApply    types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (a ApplicationLidarr) toApplication() *Application {
@@ -87,6 +89,11 @@ func (r *ApplicationLidarrResource) Schema(_ context.Context, _ resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Applications -->\nApplication Lidarr resource.\nFor more information refer to [Application](https://wiki.servarr.com/prowlarr/settings#applications) and [Lidarr](https://wiki.servarr.com/prowlarr/supported#lidarr).",
 		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Test the configuration against Prowlarr before applying it.",
+				Optional:            true,
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Application name.",
 				Required:            true,
@@ -136,10 +143,7 @@ func (r *ApplicationLidarrResource) Schema(_ context.Context, _ resource.SchemaR
 }
 
 func (r *ApplicationLidarrResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
-	}
+	r.data = resourceConfigure(ctx, req, resp)
 }
 
 func (r *ApplicationLidarrResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -152,10 +156,27 @@ func (r *ApplicationLidarrResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	instance, err := r.data.instance(application.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Create new ApplicationLidarr
 	request := application.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ApplicationAPI.CreateApplications(r.auth).ApplicationResource(*request).Execute()
+	if application.TestOnApply.ValueBool() {
+		if _, err = instance.Client.ApplicationAPI.TestApplications(instance.Auth).ApplicationResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, applicationLidarrResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.ApplicationAPI.CreateApplications(instance.Auth).ApplicationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, applicationLidarrResourceName, err))
 
@@ -178,10 +199,17 @@ func (r *ApplicationLidarrResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	instance, err := r.data.instance(application.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get ApplicationLidarr current value
-	response, _, err := r.client.ApplicationAPI.GetApplicationsById(r.auth, int32(application.ID.ValueInt64())).Execute()
+	response, httpResp, err := instance.Client.ApplicationAPI.GetApplicationsById(instance.Auth, int32(application.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, applicationLidarrResourceName, err))
+		helpers.HandleReadError(ctx, applicationLidarrResourceName, err, httpResp, resp)
 
 		return
 	}
@@ -202,10 +230,27 @@ func (r *ApplicationLidarrResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	instance, err := r.data.instance(application.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Update ApplicationLidarr
 	request := application.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ApplicationAPI.UpdateApplications(r.auth, strconv.Itoa(int(request.GetId()))).ApplicationResource(*request).Execute()
+	if application.TestOnApply.ValueBool() {
+		if _, err = instance.Client.ApplicationAPI.TestApplications(instance.Auth).ApplicationResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, applicationLidarrResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.ApplicationAPI.UpdateApplications(instance.Auth, strconv.Itoa(int(request.GetId()))).ApplicationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, applicationLidarrResourceName, err))
 
@@ -219,16 +264,27 @@ func (r *ApplicationLidarrResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *ApplicationLidarrResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID   int64
+		name types.String
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	instance, err := r.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Delete ApplicationLidarr current value
-	_, err := r.client.ApplicationAPI.DeleteApplications(r.auth, int32(ID)).Execute()
+	_, err = instance.Client.ApplicationAPI.DeleteApplications(instance.Auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, applicationLidarrResourceName, err))
 
@@ -239,7 +295,12 @@ func (r *ApplicationLidarrResource) Delete(ctx context.Context, req resource.Del
 	resp.State.RemoveResource(ctx)
 }
 
+func (r *ApplicationLidarrResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateApplicationCategories(ctx, r.data, req, resp)
+}
+
 func (r *ApplicationLidarrResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importInstance(ctx, r.data, req.ID, resp)
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
 	tflog.Trace(ctx, "imported "+applicationLidarrResourceName+": "+req.ID)
 }
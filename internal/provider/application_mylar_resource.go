@@ -29,6 +29,7 @@ const (
 var (
 	_ resource.Resource                = &ApplicationMylarResource{}
 	_ resource.ResourceWithImportState = &ApplicationMylarResource{}
+	_ resource.ResourceWithModifyPlan  = &ApplicationMylarResource{}
 )
 
 func NewApplicationMylarResource() resource.Resource {
@@ -37,12 +38,12 @@ func NewApplicationMylarResource() resource.Resource {
 
 // ApplicationMylarResource defines the application implementation.
 type ApplicationMylarResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // ApplicationMylar describes the application data model.
 type ApplicationMylar struct {
+	Instance       types.String `tfsdk:"instance"`
 	SyncCategories types.Set    `tfsdk:"sync_categories"`
 	Tags           types.Set    `tfsdk:"tags"`
 	Name           types.String `tfsdk:"name"`
@@ -51,6 +52,7 @@ type ApplicationMylar struct {
 	BaseURL        types.String `tfsdk:"base_url"`
 	APIKey         types.String `tfsdk:"api_key"`
 	ID             types.Int64  `tfsdk:"id"`
+	TestOnApply    types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (a ApplicationMylar) toApplication() *Application {
@@ -87,6 +89,11 @@ func (r *ApplicationMylarResource) Schema(_ context.Context, _ resource.SchemaRe
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Applications -->\nApplication Mylar resource.\nFor more information refer to [Application](https://wiki.servarr.com/prowlarr/settings#applications) and [Mylar](https://wiki.servarr.com/prowlarr/supported#mylar).",
 		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Test the configuration against Prowlarr before applying it.",
+				Optional:            true,
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Application name.",
 				Required:            true,
@@ -136,10 +143,7 @@ func (r *ApplicationMylarResource) Schema(_ context.Context, _ resource.SchemaRe
 }
 
 func (r *ApplicationMylarResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
-	}
+	r.data = resourceConfigure(ctx, req, resp)
 }
 
 func (r *ApplicationMylarResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -152,10 +156,27 @@ func (r *ApplicationMylarResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	instance, err := r.data.instance(application.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Create new ApplicationMylar
 	request := application.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ApplicationAPI.CreateApplications(r.auth).ApplicationResource(*request).Execute()
+	if application.TestOnApply.ValueBool() {
+		if _, err = instance.Client.ApplicationAPI.TestApplications(instance.Auth).ApplicationResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, applicationMylarResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.ApplicationAPI.CreateApplications(instance.Auth).ApplicationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, applicationMylarResourceName, err))
 
@@ -178,10 +199,17 @@ func (r *ApplicationMylarResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	instance, err := r.data.instance(application.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get ApplicationMylar current value
-	response, _, err := r.client.ApplicationAPI.GetApplicationsById(r.auth, int32(application.ID.ValueInt64())).Execute()
+	response, httpResp, err := instance.Client.ApplicationAPI.GetApplicationsById(instance.Auth, int32(application.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, applicationMylarResourceName, err))
+		helpers.HandleReadError(ctx, applicationMylarResourceName, err, httpResp, resp)
 
 		return
 	}
@@ -202,10 +230,27 @@ func (r *ApplicationMylarResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	instance, err := r.data.instance(application.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Update ApplicationMylar
 	request := application.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ApplicationAPI.UpdateApplications(r.auth, strconv.Itoa(int(request.GetId()))).ApplicationResource(*request).Execute()
+	if application.TestOnApply.ValueBool() {
+		if _, err = instance.Client.ApplicationAPI.TestApplications(instance.Auth).ApplicationResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, applicationMylarResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.ApplicationAPI.UpdateApplications(instance.Auth, strconv.Itoa(int(request.GetId()))).ApplicationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, applicationMylarResourceName, err))
 
@@ -219,16 +264,27 @@ func (r *ApplicationMylarResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *ApplicationMylarResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID   int64
+		name types.String
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	instance, err := r.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Delete ApplicationMylar current value
-	_, err := r.client.ApplicationAPI.DeleteApplications(r.auth, int32(ID)).Execute()
+	_, err = instance.Client.ApplicationAPI.DeleteApplications(instance.Auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, applicationMylarResourceName, err))
 
@@ -239,7 +295,12 @@ func (r *ApplicationMylarResource) Delete(ctx context.Context, req resource.Dele
 	resp.State.RemoveResource(ctx)
 }
 
+func (r *ApplicationMylarResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateApplicationCategories(ctx, r.data, req, resp)
+}
+
 func (r *ApplicationMylarResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importInstance(ctx, r.data, req.ID, resp)
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
 	tflog.Trace(ctx, "imported "+applicationMylarResourceName+": "+req.ID)
 }
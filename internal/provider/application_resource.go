@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"github.com/devopsarr/prowlarr-go/prowlarr"
@@ -26,8 +27,12 @@ const applicationResourceName = "application"
 var (
 	_ resource.Resource                = &ApplicationResource{}
 	_ resource.ResourceWithImportState = &ApplicationResource{}
+	_ resource.ResourceWithModifyPlan  = &ApplicationResource{}
 )
 
+// applicationCategoryAttributes lists the attributes holding category IDs, validated at plan time.
+var applicationCategoryAttributes = []string{"sync_categories", "anime_sync_categories"}
+
 var applicationFields = helpers.Fields{
 	Strings:   []string{"prowlarrUrl", "baseUrl", "apiKey"},
 	IntSlices: []string{"syncCategories", "animeSyncCategories"},
@@ -39,8 +44,7 @@ func NewApplicationResource() resource.Resource {
 
 // ApplicationResource defines the application implementation.
 type ApplicationResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // Application describes the application data model.
@@ -58,6 +62,20 @@ type Application struct {
 	ID                  types.Int64  `tfsdk:"id"`
 }
 
+// TestableApplication extends Application with the resource only attributes.
+type TestableApplication struct {
+	Instance types.String `tfsdk:"instance"`
+	APIKeyWO types.String `tfsdk:"api_key_wo"`
+	Application
+	APIKeyWOVersion types.Int64 `tfsdk:"api_key_wo_version"`
+	TestOnApply     types.Bool  `tfsdk:"test_on_apply"`
+}
+
+// applicationWriteOnlyFields maps the write only attributes to their API field.
+var applicationWriteOnlyFields = map[string]string{
+	"api_key" + writeOnlySuffix: "apiKey",
+}
+
 func (a Application) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -83,6 +101,11 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Applications -->\nGeneric Application resource. When possible use a specific resource instead.\nFor more information refer to [Application](https://wiki.servarr.com/prowlarr/settings#applications).",
 		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Test the configuration against Prowlarr before applying it.",
+				Optional:            true,
+			},
 			"config_contract": schema.StringAttribute{
 				MarkdownDescription: "Application configuration template.",
 				Required:            true,
@@ -132,6 +155,8 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Computed:            true,
 				Sensitive:           true,
 			},
+			"api_key_wo":         writeOnlyAttribute("api_key", "API key."),
+			"api_key_wo_version": writeOnlyVersionAttribute("api_key_wo"),
 			"sync_categories": schema.SetAttribute{
 				MarkdownDescription: "Sync categories.",
 				Optional:            true,
@@ -149,15 +174,12 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 }
 
 func (r *ApplicationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
-	}
+	r.data = resourceConfigure(ctx, req, resp)
 }
 
 func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var application *Application
+	var application *TestableApplication
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &application)...)
 
@@ -165,10 +187,28 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	instance, err := r.data.instance(application.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Create new Application
 	request := application.read(ctx, &resp.Diagnostics)
+	request.SetFields(readWriteOnlyFields(ctx, req.Config, request.GetFields(), applicationWriteOnlyFields, &resp.Diagnostics))
+
+	if application.TestOnApply.ValueBool() {
+		if _, err = instance.Client.ApplicationAPI.TestApplications(instance.Auth).ApplicationResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, applicationResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
 
-	response, _, err := r.client.ApplicationAPI.CreateApplications(r.auth).ApplicationResource(*request).Execute()
+	response, _, err := instance.Client.ApplicationAPI.CreateApplications(instance.Auth).ApplicationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, applicationResourceName, err))
 
@@ -178,16 +218,18 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 	tflog.Trace(ctx, "created "+applicationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Application
+	var state TestableApplication
 
-	state.writeSensitive(application)
+	state.TestOnApply = application.TestOnApply
+	state.APIKeyWOVersion = application.APIKeyWOVersion
+	state.writeSensitive(&application.Application)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var application *Application
+	var application *TestableApplication
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &application)...)
 
@@ -195,10 +237,17 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	instance, err := r.data.instance(application.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get Application current value
-	response, _, err := r.client.ApplicationAPI.GetApplicationsById(r.auth, int32(application.ID.ValueInt64())).Execute()
+	response, httpResp, err := instance.Client.ApplicationAPI.GetApplicationsById(instance.Auth, int32(application.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, applicationResourceName, err))
+		helpers.HandleReadError(ctx, applicationResourceName, err, httpResp, resp)
 
 		return
 	}
@@ -206,16 +255,18 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 	tflog.Trace(ctx, "read "+applicationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Application
+	var state TestableApplication
 
-	state.writeSensitive(application)
+	state.TestOnApply = application.TestOnApply
+	state.APIKeyWOVersion = application.APIKeyWOVersion
+	state.writeSensitive(&application.Application)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var application *Application
+	var application *TestableApplication
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &application)...)
 
@@ -223,10 +274,28 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	instance, err := r.data.instance(application.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Update Application
 	request := application.read(ctx, &resp.Diagnostics)
+	request.SetFields(readWriteOnlyFields(ctx, req.Config, request.GetFields(), applicationWriteOnlyFields, &resp.Diagnostics))
 
-	response, _, err := r.client.ApplicationAPI.UpdateApplications(r.auth, strconv.Itoa(int(request.GetId()))).ApplicationResource(*request).Execute()
+	if application.TestOnApply.ValueBool() {
+		if _, err = instance.Client.ApplicationAPI.TestApplications(instance.Auth).ApplicationResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, applicationResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.ApplicationAPI.UpdateApplications(instance.Auth, strconv.Itoa(int(request.GetId()))).ApplicationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, applicationResourceName, err))
 
@@ -236,24 +305,37 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 	tflog.Trace(ctx, "updated "+applicationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Application
+	var state TestableApplication
 
-	state.writeSensitive(application)
+	state.TestOnApply = application.TestOnApply
+	state.APIKeyWOVersion = application.APIKeyWOVersion
+	state.writeSensitive(&application.Application)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID   int64
+		name types.String
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	instance, err := r.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Delete Application current value
-	_, err := r.client.ApplicationAPI.DeleteApplications(r.auth, int32(ID)).Execute()
+	_, err = instance.Client.ApplicationAPI.DeleteApplications(instance.Auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, applicationResourceName, err))
 
@@ -264,7 +346,12 @@ func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteReq
 	resp.State.RemoveResource(ctx)
 }
 
+func (r *ApplicationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateApplicationCategories(ctx, r.data, req, resp)
+}
+
 func (r *ApplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importInstance(ctx, r.data, req.ID, resp)
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
 	tflog.Trace(ctx, "imported "+applicationResourceName+": "+req.ID)
 }
@@ -303,3 +390,65 @@ func (a *Application) writeSensitive(application *Application) {
 		a.APIKey = application.APIKey
 	}
 }
+
+// validateApplicationCategories checks that each configured category exists in Prowlarr.
+func validateApplicationCategories(ctx context.Context, providerData *ProwlarrData, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy or if the provider is not configured yet.
+	if req.Plan.Raw.IsNull() || providerData == nil {
+		return
+	}
+
+	var name types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
+
+	if resp.Diagnostics.HasError() || name.IsUnknown() {
+		return
+	}
+
+	instance, err := providerData.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	var categories []prowlarr.IndexerCategory
+
+	for _, name := range applicationCategoryAttributes {
+		if _, ok := req.Config.Schema.GetAttributes()[name]; !ok {
+			continue
+		}
+
+		// Config is used instead of plan, since unset values are unknown in plan.
+		var configured types.Set
+
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &configured)...)
+
+		if resp.Diagnostics.HasError() || configured.IsNull() || configured.IsUnknown() {
+			continue
+		}
+
+		ids := make([]types.Int64, len(configured.Elements()))
+		resp.Diagnostics.Append(configured.ElementsAs(ctx, &ids, true)...)
+
+		if categories == nil {
+			if categories, err = instance.IndexerCategories(); err != nil {
+				resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, indexerCategoriesDataSourceName, err))
+
+				return
+			}
+		}
+
+		for _, id := range ids {
+			if id.IsNull() || id.IsUnknown() {
+				continue
+			}
+
+			if _, err := helpers.FindCategoryByID(categories, id.ValueInt64()); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root(name), helpers.ResourceError,
+					fmt.Sprintf("Category %d does not exist in Prowlarr, the valid ones are listed by the prowlarr_indexer_categories data source.", id.ValueInt64()))
+			}
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccApplicationResource(t *testing.T) {
@@ -66,3 +67,51 @@ func testAccApplicationResourceConfig(name, prowlarr string) string {
 		sync_categories = [3000, 3010, 3030]
 	}`, name, prowlarr)
 }
+
+func TestAccApplicationResourceWriteOnly(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccApplicationResourceWriteOnlyConfig("resourceWOTest", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("prowlarr_application.test", "api_key_wo"),
+					resource.TestCheckResourceAttr("prowlarr_application.test", "api_key_wo_version", "1"),
+					resource.TestCheckResourceAttrSet("prowlarr_application.test", "id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccApplicationResourceWriteOnlyConfig("resourceWOTest", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("prowlarr_application.test", "api_key_wo"),
+					resource.TestCheckResourceAttr("prowlarr_application.test", "api_key_wo_version", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccApplicationResourceWriteOnlyConfig(name string, version int) string {
+	return fmt.Sprintf(`
+	resource "prowlarr_application" "test" {
+		name = "%s"
+		sync_level = "disabled"
+		implementation  = "Lidarr"
+		config_contract = "LidarrSettings"
+
+		base_url = "http://localhost:8686"
+		prowlarr_url = "http://localhost:9696"
+		api_key_wo = "APIKey%d"
+		api_key_wo_version = %d
+		sync_categories = [3000, 3010, 3030]
+	}`, name, version, version)
+}
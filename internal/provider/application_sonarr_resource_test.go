@@ -15,6 +15,16 @@ func TestAccApplicationSonarrResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Failing test on apply
+			{
+				Config:      testAccApplicationSonarrResourceTestOnApplyConfig,
+				ExpectError: regexp.MustCompile("Validation Error"),
+			},
+			// Unknown category
+			{
+				Config:      testAccApplicationSonarrResourceCategoryConfig,
+				ExpectError: regexp.MustCompile("Category 5001 does not exist"),
+			},
 			// Unauthorized Create
 			{
 				Config:      testAccApplicationSonarrResourceConfig("resourceSonarrTest", "false") + testUnauthorizedProvider,
@@ -65,3 +75,28 @@ func testAccApplicationSonarrResourceConfig(name, prowlarr string) string {
 		anime_sync_categories = [5070]
 	}`, name, prowlarr)
 }
+
+const testAccApplicationSonarrResourceTestOnApplyConfig = `
+resource "prowlarr_application_sonarr" "test" {
+	name = "resourceSonarrTestOnApply"
+	sync_level = "disabled"
+	test_on_apply = true
+
+	base_url = "http://localhost:1"
+	prowlarr_url = "http://localhost:9696"
+	api_key = "APIKey"
+}
+`
+
+const testAccApplicationSonarrResourceCategoryConfig = `
+resource "prowlarr_application_sonarr" "test" {
+	name = "resourceSonarrCategory"
+	sync_level = "disabled"
+
+	base_url = "http://localhost:8989"
+	prowlarr_url = "http://localhost:9696"
+	api_key = "APIKey"
+	sync_categories = [5010]
+	anime_sync_categories = [5001]
+}
+`
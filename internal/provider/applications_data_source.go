@@ -4,11 +4,10 @@ import (
 	"context"
 	"strconv"
 
-	"github.com/devopsarr/prowlarr-go/prowlarr"
 	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
-
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,12 +23,12 @@ func NewApplicationsDataSource() datasource.DataSource {
 
 // ApplicationsDataSource defines the applications implementation.
 type ApplicationsDataSource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // Applications describes the applications data model.
 type Applications struct {
+	Instance     types.String `tfsdk:"instance"`
 	Applications types.Set    `tfsdk:"applications"`
 	ID           types.String `tfsdk:"id"`
 }
@@ -43,6 +42,7 @@ func (d *ApplicationsDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 		// This description is used by the documentation generator and the delay server.
 		MarkdownDescription: "<!-- subcategory:Applications -->\nList all available [Applications](../resources/application).",
 		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
 			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -109,15 +109,28 @@ func (d *ApplicationsDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 }
 
 func (d *ApplicationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
-		d.client = client
-		d.auth = auth
-	}
+	d.data = dataSourceConfigure(ctx, req, resp)
 }
 
-func (d *ApplicationsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *ApplicationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the instance from config
+	var name types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := d.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get applications current value
-	response, _, err := d.client.ApplicationAPI.ListApplications(d.auth).Execute()
+	response, _, err := instance.Client.ApplicationAPI.ListApplications(instance.Auth).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, applicationsDataSourceName, err))
 
@@ -133,5 +146,5 @@ func (d *ApplicationsDataSource) Read(ctx context.Context, _ datasource.ReadRequ
 
 	appList, diags := types.SetValueFrom(ctx, Application{}.getType(), applications)
 	resp.Diagnostics.Append(diags...)
-	resp.Diagnostics.Append(resp.State.Set(ctx, Applications{Applications: appList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, Applications{Instance: name, Applications: appList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
 }
@@ -0,0 +1,464 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	backupResourceName = "backup"
+	backupCommandName  = "Backup"
+	backupTimeout      = 5 * time.Minute
+)
+
+var errNoManualBackup = errors.New("no manual backup found")
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &BackupResource{}
+	_ resource.ResourceWithImportState = &BackupResource{}
+)
+
+func NewBackupResource() resource.Resource {
+	return &BackupResource{}
+}
+
+// BackupResource defines the backup implementation.
+type BackupResource struct {
+	data *ProwlarrData
+}
+
+// Backup describes the backup data model.
+type Backup struct {
+	Name types.String `tfsdk:"name"`
+	Path types.String `tfsdk:"path"`
+	Type types.String `tfsdk:"type"`
+	Time types.String `tfsdk:"time"`
+	ID   types.Int64  `tfsdk:"id"`
+	Size types.Int64  `tfsdk:"size"`
+}
+
+// RestorableBackup is the backup resource data model.
+type RestorableBackup struct {
+	Instance        types.String `tfsdk:"instance"`
+	RestoreFromName types.String `tfsdk:"restore_from_name"`
+	RestoreFromFile types.String `tfsdk:"restore_from_file"`
+	Backup
+}
+
+func (r *BackupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + backupResourceName
+}
+
+func (r *BackupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->\nBackup resource.\nBy default a manual backup is created, and deleted on destroy.\nIf `restore_from_name` or `restore_from_file` is set, the given backup is restored instead and Prowlarr restarts; destroying the resource has no effect.\nFor more information refer to [Backup](https://wiki.servarr.com/prowlarr/system#backup) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"restore_from_name": schema.StringAttribute{
+				MarkdownDescription: "Name of an existing backup to restore.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("restore_from_file")),
+				},
+			},
+			"restore_from_file": schema.StringAttribute{
+				MarkdownDescription: "Path of a local backup archive to upload and restore.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Backup ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Backup name.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Backup path.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Backup type.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"time": schema.StringAttribute{
+				MarkdownDescription: "Backup time (RFC3339).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Backup size in bytes.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *BackupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.data = resourceConfigure(ctx, req, resp)
+}
+
+func (r *BackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var backup *RestorableBackup
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &backup)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.data.instance(backup.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	if backup.isRestore() {
+		r.restore(ctx, instance, backup, resp)
+
+		return
+	}
+
+	// Backups have no ID in the command result, so the existing ones are listed to find the new one
+	existing, _, err := instance.Client.BackupAPI.ListSystemBackup(instance.Auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, backupResourceName, err))
+
+		return
+	}
+
+	// Create new Backup
+	command := prowlarr.NewCommandResource()
+	command.SetName(backupCommandName)
+
+	queued, _, err := instance.Client.CommandAPI.CreateCommand(instance.Auth).CommandResource(*command).Execute()
+	if err == nil {
+		command, err = waitCommand(ctx, instance.Client, instance.Auth, queued.GetId(), backupTimeout)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, backupResourceName, err))
+
+		return
+	}
+
+	response, err := r.newManualBackup(instance, existing, command)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, backupResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+backupResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	backup.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &backup)...)
+}
+
+func (r *BackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var backup *RestorableBackup
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &backup)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.data.instance(backup.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	// Restores are one-shot operations, nothing to refresh
+	if backup.isRestore() {
+		return
+	}
+
+	// Get backup current value
+	response, _, err := instance.Client.BackupAPI.ListSystemBackup(instance.Auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, backupResourceName, err))
+
+		return
+	}
+
+	for _, b := range response {
+		if int64(b.GetId()) == backup.ID.ValueInt64() {
+			tflog.Trace(ctx, "read "+backupResourceName+": "+strconv.Itoa(int(b.GetId())))
+			// Map response body to resource schema attribute
+			backup.write(&b)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &backup)...)
+
+			return
+		}
+	}
+
+	tflog.Warn(ctx, "removing "+backupResourceName+" from state because it no longer exists: "+strconv.Itoa(int(backup.ID.ValueInt64())))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *BackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configurable attribute requires replacement, only refresh the state
+	var backup *RestorableBackup
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &backup)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+backupResourceName+": "+strconv.Itoa(int(backup.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &backup)...)
+}
+
+func (r *BackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var backup *RestorableBackup
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &backup)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.data.instance(backup.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	// Restores cannot be undone just removing them from state
+	if backup.isRestore() {
+		tflog.Trace(ctx, "decoupled "+backupResourceName+": "+backup.Name.ValueString())
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	// Delete backup current value
+	_, err = instance.Client.BackupAPI.DeleteSystemBackup(instance.Auth, int32(backup.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, backupResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "deleted "+backupResourceName+": "+strconv.Itoa(int(backup.ID.ValueInt64())))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *BackupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importInstance(ctx, r.data, req.ID, resp)
+	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	tflog.Trace(ctx, "imported "+backupResourceName+": "+req.ID)
+}
+
+// restore uploads a local archive or restores an existing backup by name.
+func (r *BackupResource) restore(ctx context.Context, instance *ProwlarrData, backup *RestorableBackup, resp *resource.CreateResponse) {
+	if !backup.RestoreFromFile.IsNull() {
+		file := backup.RestoreFromFile.ValueString()
+
+		if err := r.upload(ctx, instance, file); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("restore_from_file"), helpers.ClientError, helpers.ParseClientError(helpers.Restore, backupResourceName, err))
+
+			return
+		}
+
+		backup.writeUpload(file)
+		tflog.Trace(ctx, "restored "+backupResourceName+": "+file)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &backup)...)
+
+		return
+	}
+
+	name := backup.RestoreFromName.ValueString()
+
+	response, _, err := instance.Client.BackupAPI.ListSystemBackup(instance.Auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Restore, backupResourceName, err))
+
+		return
+	}
+
+	var found *prowlarr.BackupResource
+
+	for _, b := range response {
+		if b.GetName() == name {
+			found = &b
+
+			break
+		}
+	}
+
+	if found == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("restore_from_name"), helpers.ResourceError, helpers.ParseNotFoundError(backupResourceName, "name", name))
+
+		return
+	}
+
+	if _, err := instance.Client.BackupAPI.CreateSystemBackupRestoreById(instance.Auth, found.GetId()).Execute(); err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Restore, backupResourceName, err))
+
+		return
+	}
+
+	backup.write(found)
+	tflog.Trace(ctx, "restored "+backupResourceName+": "+name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &backup)...)
+}
+
+// upload sends a local archive to the restore endpoint.
+func (r *BackupResource) upload(ctx context.Context, instance *ProwlarrData, file string) error {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile("restore", filepath.Base(file))
+	if err != nil {
+		return err
+	}
+
+	if _, err = part.Write(content); err != nil {
+		return err
+	}
+
+	if err = writer.Close(); err != nil {
+		return err
+	}
+
+	_, err = helpers.RawRequest(ctx, instance.Auth, instance.Client, "BackupAPIService.CreateSystemBackupRestoreUpload", http.MethodPost, "/api/v1/system/backup/restore/upload", writer.FormDataContentType(), body)
+
+	return err
+}
+
+// newManualBackup returns the manual backup taken by the command, see findNewManualBackup.
+func (r *BackupResource) newManualBackup(instance *ProwlarrData, existing []prowlarr.BackupResource, command *prowlarr.CommandResource) (*prowlarr.BackupResource, error) {
+	response, _, err := instance.Client.BackupAPI.ListSystemBackup(instance.Auth).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	backup := findNewManualBackup(response, existing, command)
+	if backup == nil {
+		return nil, errNoManualBackup
+	}
+
+	return backup, nil
+}
+
+// findNewManualBackup returns the latest manual backup which was not in the existing ones and was taken while the command ran,
+// so that scheduled or concurrent backups are not picked.
+func findNewManualBackup(backups, existing []prowlarr.BackupResource, command *prowlarr.CommandResource) *prowlarr.BackupResource {
+	// backup times have a one second precision
+	var started, ended time.Time
+
+	if t, ok := command.GetStartedOk(); ok && t != nil {
+		started = t.Truncate(time.Second)
+	}
+
+	if t, ok := command.GetEndedOk(); ok && t != nil {
+		ended = t.Add(time.Second)
+	}
+
+	var latest *prowlarr.BackupResource
+
+	for i, b := range backups {
+		if b.GetType() != prowlarr.BACKUPTYPE_MANUAL || slices.ContainsFunc(existing, func(e prowlarr.BackupResource) bool { return e.GetName() == b.GetName() }) {
+			continue
+		}
+
+		if b.GetTime().Before(started) || (!ended.IsZero() && b.GetTime().After(ended)) {
+			continue
+		}
+
+		if latest == nil || b.GetTime().After(latest.GetTime()) {
+			latest = &backups[i]
+		}
+	}
+
+	return latest
+}
+
+// isRestore reports whether the resource restores a backup instead of creating one.
+func (b *RestorableBackup) isRestore() bool {
+	return !b.RestoreFromName.IsNull() || !b.RestoreFromFile.IsNull()
+}
+
+func (b *Backup) write(backup *prowlarr.BackupResource) {
+	backupTime, _ := backup.GetTimeOk()
+
+	b.ID = types.Int64Value(int64(backup.GetId()))
+	b.Name = types.StringValue(backup.GetName())
+	b.Path = types.StringValue(backup.GetPath())
+	b.Type = types.StringValue(string(backup.GetType()))
+	b.Size = types.Int64Value(backup.GetSize())
+	b.Time = helpers.TimeValue(backupTime)
+}
+
+func (b *Backup) writeUpload(file string) {
+	b.ID = types.Int64Value(0)
+	b.Name = types.StringValue(filepath.Base(file))
+	b.Path = types.StringValue(file)
+	b.Type = types.StringValue(string(prowlarr.BACKUPTYPE_MANUAL))
+	b.Size = types.Int64Null()
+	b.Time = types.StringNull()
+
+	if info, err := os.Stat(file); err == nil {
+		b.Size = types.Int64Value(info.Size())
+		b.Time = types.StringValue(info.ModTime().UTC().Format(time.RFC3339))
+	}
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccBackupResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccBackupResourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccBackupResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_backup.test", "type", "manual"),
+					resource.TestCheckResourceAttrSet("prowlarr_backup.test", "id"),
+					resource.TestCheckResourceAttrSet("prowlarr_backup.test", "name"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccBackupResourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Restore not existing backup
+			{
+				Config:      testAccBackupResourceConfig + testAccBackupResourceRestoreConfig,
+				ExpectError: regexp.MustCompile("Unable to find backup"),
+			},
+			// Upload not existing archive
+			{
+				Config:      testAccBackupResourceConfig + testAccBackupResourceUploadConfig,
+				ExpectError: regexp.MustCompile("no such file or directory"),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "prowlarr_backup.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccBackupResourceConfig = `
+resource "prowlarr_backup" "test" {
+}
+`
+
+const testAccBackupResourceRestoreConfig = `
+resource "prowlarr_backup" "restore" {
+	restore_from_name = "not_existing.zip"
+}
+`
+
+const testAccBackupResourceUploadConfig = `
+resource "prowlarr_backup" "upload" {
+	restore_from_file = "/not/existing/backup.zip"
+}
+`
+
+func TestFindNewManualBackup(t *testing.T) {
+	t.Parallel()
+
+	started := time.Date(2024, 1, 1, 10, 0, 0, 500, time.UTC)
+
+	backup := func(name string, backupType prowlarr.BackupType, offset time.Duration) prowlarr.BackupResource {
+		b := prowlarr.NewBackupResource()
+		b.SetName(name)
+		b.SetType(backupType)
+		b.SetTime(started.Add(offset))
+
+		return *b
+	}
+
+	command := prowlarr.NewCommandResource()
+	command.SetStarted(started)
+	command.SetEnded(started.Add(10 * time.Second))
+
+	old := backup("old", prowlarr.BACKUPTYPE_MANUAL, -time.Hour)
+	created := backup("created", prowlarr.BACKUPTYPE_MANUAL, 5*time.Second)
+	scheduled := backup("scheduled", prowlarr.BACKUPTYPE_SCHEDULED, 6*time.Second)
+	concurrent := backup("concurrent", prowlarr.BACKUPTYPE_MANUAL, time.Minute)
+	// listed before the command was queued, even if its time is in the command window
+	existing := backup("existing", prowlarr.BACKUPTYPE_MANUAL, 7*time.Second)
+
+	tests := map[string]struct {
+		expected string
+		backups  []prowlarr.BackupResource
+	}{
+		"created": {
+			backups:  []prowlarr.BackupResource{old, existing, scheduled, created, concurrent},
+			expected: "created",
+		},
+		"missing": {
+			backups: []prowlarr.BackupResource{old, existing, scheduled, concurrent},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			found := findNewManualBackup(test.backups, []prowlarr.BackupResource{old, existing}, command)
+			if test.expected == "" {
+				assert.Nil(t, found)
+
+				return
+			}
+
+			assert.Equal(t, test.expected, found.GetName())
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const backupsDataSourceName = "backups"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BackupsDataSource{}
+
+func NewBackupsDataSource() datasource.DataSource {
+	return &BackupsDataSource{}
+}
+
+// BackupsDataSource defines the backups implementation.
+type BackupsDataSource struct {
+	data *ProwlarrData
+}
+
+// Backups describes the backups data model.
+type Backups struct {
+	Instance types.String `tfsdk:"instance"`
+	Backups  types.Set    `tfsdk:"backups"`
+	ID       types.String `tfsdk:"id"`
+}
+
+func (d *BackupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + backupsDataSourceName
+}
+
+func (d *BackupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:System -->\nList all available [Backups](../resources/backup).",
+		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"backups": schema.SetNestedAttribute{
+				MarkdownDescription: "Backup list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Backup ID.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Backup name.",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Backup path.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Backup type.",
+							Computed:            true,
+						},
+						"time": schema.StringAttribute{
+							MarkdownDescription: "Backup time (RFC3339).",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "Backup size in bytes.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BackupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.data = dataSourceConfigure(ctx, req, resp)
+}
+
+func (d *BackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Backups
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := d.data.instance(data.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	// Get backups current value
+	response, _, err := instance.Client.BackupAPI.ListSystemBackup(instance.Auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, backupsDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+backupsDataSourceName)
+	// Map response body to resource schema attribute
+	backups := make([]Backup, len(response))
+	for i, b := range response {
+		backups[i].write(&b)
+	}
+
+	tfsdk.ValueFrom(ctx, backups, data.Backups.Type(ctx), &data.Backups)
+	// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+	data.ID = types.StringValue(backupsDataSourceName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
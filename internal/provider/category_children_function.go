@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const categoryChildrenFunctionName = "category_children"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &CategoryChildrenFunction{}
+
+func NewCategoryChildrenFunction() function.Function {
+	return &CategoryChildrenFunction{}
+}
+
+// CategoryChildrenFunction defines the category children lookup implementation.
+type CategoryChildrenFunction struct{}
+
+func (f *CategoryChildrenFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = categoryChildrenFunctionName
+}
+
+func (f *CategoryChildrenFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Newznab sub category IDs",
+		MarkdownDescription: "Return the IDs of the sub categories of a standard Newznab category (e.g. `5000` returns `[5010, 5020, ...]`). Sub categories have no children, so they return an empty list. The lookup does not need any API call.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:                "id",
+				MarkdownDescription: "Category ID.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.Int64Type,
+		},
+	}
+}
+
+func (f *CategoryChildrenFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id int64
+
+	resp.Error = req.Arguments.Get(ctx, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	category, err := helpers.FindCategoryByID(helpers.NewznabCategories(), id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+
+		return
+	}
+
+	children := make([]int64, len(category.SubCategories))
+	for i, c := range category.SubCategories {
+		children[i] = int64(c.GetId())
+	}
+
+	resp.Error = resp.Result.Set(ctx, children)
+}
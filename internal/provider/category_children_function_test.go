@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccCategoryChildrenFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			// Unknown category
+			{
+				Config:      `output "test" { value = provider::prowlarr::category_children(5001) }`,
+				ExpectError: regexp.MustCompile("unknown category"),
+			},
+			// Lookup testing
+			{
+				Config: `
+				output "parent" { value = provider::prowlarr::category_children(8000) }
+				output "child" { value = provider::prowlarr::category_children(8010) }
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("parent", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.Int64Exact(8010),
+						knownvalue.Int64Exact(8020),
+					})),
+					statecheck.ExpectKnownOutputValue("child", knownvalue.ListSizeExact(0)),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+const categoryIDFunctionName = "category_id"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &CategoryIDFunction{}
+
+func NewCategoryIDFunction() function.Function {
+	return &CategoryIDFunction{}
+}
+
+// CategoryIDFunction defines the category ID lookup implementation.
+type CategoryIDFunction struct{}
+
+func (f *CategoryIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = categoryIDFunctionName
+}
+
+func (f *CategoryIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Newznab category ID",
+		MarkdownDescription: "Return the ID of a standard Newznab category from its name (e.g. `TV/HD` is `5040`). The lookup is case insensitive and it does not need any API call.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "Category name.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *CategoryIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = req.Arguments.Get(ctx, &name)
+	if resp.Error != nil {
+		return
+	}
+
+	category, err := helpers.FindCategoryByName(helpers.NewznabCategories(), name)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, int64(category.GetId()))
+}
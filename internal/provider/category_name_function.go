@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+const categoryNameFunctionName = "category_name"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &CategoryNameFunction{}
+
+func NewCategoryNameFunction() function.Function {
+	return &CategoryNameFunction{}
+}
+
+// CategoryNameFunction defines the category name lookup implementation.
+type CategoryNameFunction struct{}
+
+func (f *CategoryNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = categoryNameFunctionName
+}
+
+func (f *CategoryNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Newznab category name",
+		MarkdownDescription: "Return the name of a standard Newznab category from its ID (e.g. `5040` is `TV/HD`). The lookup does not need any API call.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:                "id",
+				MarkdownDescription: "Category ID.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *CategoryNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id int64
+
+	resp.Error = req.Arguments.Get(ctx, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	category, err := helpers.FindCategoryByID(helpers.NewznabCategories(), id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, category.GetName())
+}
@@ -0,0 +1,332 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	commandResourceName = "command"
+	commandPollInterval = time.Second
+)
+
+var (
+	errCommandFailed  = errors.New("command failed")
+	errCommandTimeout = errors.New("command timed out")
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CommandResource{}
+
+func NewCommandResource() resource.Resource {
+	return &CommandResource{}
+}
+
+// CommandResource defines the command implementation.
+type CommandResource struct {
+	data *ProwlarrData
+}
+
+// Command describes the command data model.
+type Command struct {
+	Instance types.String `tfsdk:"instance"`
+	Triggers types.Map    `tfsdk:"triggers"`
+	Name     types.String `tfsdk:"name"`
+	Body     types.String `tfsdk:"body"`
+	Status   types.String `tfsdk:"status"`
+	Message  types.String `tfsdk:"message"`
+	Queued   types.String `tfsdk:"queued"`
+	Started  types.String `tfsdk:"started"`
+	Ended    types.String `tfsdk:"ended"`
+	Duration types.String `tfsdk:"duration"`
+	ID       types.Int64  `tfsdk:"id"`
+	Timeout  types.Int64  `tfsdk:"timeout"`
+}
+
+func (r *CommandResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + commandResourceName
+}
+
+func (r *CommandResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->\nCommand resource.\nThe command is queued on create and polled until it completes. Change `name`, `body` or `triggers` to run it again; destroying the resource has no effect.\nIf the command fails or times out, its final `status` and `message` are stored and the resource is marked as tainted.\nFor more information refer to [Tasks](https://wiki.servarr.com/prowlarr/system#tasks) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Command name, e.g. `ApplicationIndexerSync`, `CheckHealth`, `Backup`, `CleanUpRecycleBin`, `ClearLogs`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"body": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded command parameters, e.g. `jsonencode({ forceSync = true })`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that run the command again when changed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "Maximum time in seconds to wait for the command to complete. Defaults to `300`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(300),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Command ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Final status.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "Final message.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"queued": schema.StringAttribute{
+				MarkdownDescription: "Queued timestamp (RFC3339).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"started": schema.StringAttribute{
+				MarkdownDescription: "Started timestamp (RFC3339).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ended": schema.StringAttribute{
+				MarkdownDescription: "Ended timestamp (RFC3339).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"duration": schema.StringAttribute{
+				MarkdownDescription: "Duration.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CommandResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.data = resourceConfigure(ctx, req, resp)
+}
+
+func (r *CommandResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var command *Command
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &command)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.data.instance(command.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	var parameters map[string]interface{}
+
+	if !command.Body.IsNull() {
+		if err = json.Unmarshal([]byte(command.Body.ValueString()), &parameters); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("body"), helpers.ResourceError, fmt.Sprintf("Expected a JSON object. Got error: %s", err))
+
+			return
+		}
+	}
+
+	// Queue new Command
+	queued, err := r.queue(ctx, instance, command.Name.ValueString(), parameters)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, commandResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "queued "+commandResourceName+": "+strconv.Itoa(int(queued.GetId())))
+
+	response, err := waitCommand(ctx, instance.Client, instance.Auth, queued.GetId(), time.Duration(command.Timeout.ValueInt64())*time.Second)
+	if err != nil {
+		// Failed or timed out commands are still recorded, with their last status and message
+		if response != nil {
+			command.write(response)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &command)...)
+		}
+
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, commandResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+commandResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	command.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &command)...)
+}
+
+func (r *CommandResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Commands are one-shot operations and Prowlarr purges them, so the state is kept as it is
+	var command *Command
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &command)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+commandResourceName+": "+strconv.Itoa(int(command.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &command)...)
+}
+
+func (r *CommandResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only the timeout can change without replacement
+	var command *Command
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &command)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+commandResourceName+": "+strconv.Itoa(int(command.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &command)...)
+}
+
+func (r *CommandResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var ID int64
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Command cannot be really deleted just removing it from state
+	tflog.Trace(ctx, "decoupled "+commandResourceName+": "+strconv.Itoa(int(ID)))
+	resp.State.RemoveResource(ctx)
+}
+
+// queue sends the command, merging the optional parameters that the generated client cannot carry.
+func (r *CommandResource) queue(ctx context.Context, instance *ProwlarrData, name string, parameters map[string]interface{}) (*prowlarr.CommandResource, error) {
+	if parameters == nil {
+		request := prowlarr.NewCommandResource()
+		request.SetName(name)
+
+		response, _, err := instance.Client.CommandAPI.CreateCommand(instance.Auth).CommandResource(*request).Execute()
+
+		return response, err
+	}
+
+	parameters["name"] = name
+
+	payload, err := json.Marshal(parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := helpers.RawRequest(ctx, instance.Auth, instance.Client, "CommandAPIService.CreateCommand", http.MethodPost, "/api/v1/command", "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	response := prowlarr.NewCommandResource()
+
+	return response, json.Unmarshal(content, response)
+}
+
+// waitCommand polls a queued command until it reaches a final status or the timeout expires.
+func waitCommand(ctx context.Context, client *prowlarr.APIClient, auth context.Context, id int32, timeout time.Duration) (*prowlarr.CommandResource, error) {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		command, _, err := client.CommandAPI.GetCommandById(auth, id).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		switch command.GetStatus() {
+		case prowlarr.COMMANDSTATUS_COMPLETED:
+			return command, nil
+		case prowlarr.COMMANDSTATUS_QUEUED, prowlarr.COMMANDSTATUS_STARTED:
+		default:
+			return command, fmt.Errorf("%w: %s %s: %s", errCommandFailed, command.GetName(), command.GetStatus(), command.GetMessage())
+		}
+
+		if time.Now().After(deadline) {
+			return command, fmt.Errorf("%w: %s did not complete within %s", errCommandTimeout, command.GetName(), timeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			return command, ctx.Err()
+		case <-time.After(commandPollInterval):
+		}
+	}
+}
+
+func (c *Command) write(command *prowlarr.CommandResource) {
+	queued, _ := command.GetQueuedOk()
+	started, _ := command.GetStartedOk()
+	ended, _ := command.GetEndedOk()
+
+	c.ID = types.Int64Value(int64(command.GetId()))
+	c.Status = types.StringValue(string(command.GetStatus()))
+	c.Message = types.StringValue(command.GetMessage())
+	c.Queued = helpers.TimeValue(queued)
+	c.Started = helpers.TimeValue(started)
+	c.Ended = helpers.TimeValue(ended)
+	c.Duration = types.StringValue(command.GetDuration())
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"testing"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCommandResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccCommandResourceConfig("CheckHealth") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccCommandResourceConfig("CheckHealth"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_command.test", "status", "completed"),
+					resource.TestCheckResourceAttrSet("prowlarr_command.test", "id"),
+				),
+			},
+			// Replace and Read testing
+			{
+				Config: testAccCommandResourceConfig("ApplicationIndexerSync"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_command.test", "status", "completed"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCommandResourceConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "prowlarr_command" "test" {
+		name = "%s"
+		body = jsonencode({ forceSync = true })
+		timeout = 120
+	}`, name)
+}
+
+func TestCommandResourceFailure(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":1,"name":"ApplicationIndexerSync","status":"queued"}`))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id":1,"name":"ApplicationIndexerSync","status":"failed","message":"Sync failed"}`))
+	}))
+	defer server.Close()
+
+	parsed, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	r := &CommandResource{data: &ProwlarrData{
+		Client: prowlarr.NewAPIClient(prowlarr.NewConfiguration()),
+		Auth: context.WithValue(ctx, prowlarr.ContextServerVariables, map[string]string{
+			"protocol": parsed.Scheme,
+			"hostpath": parsed.Host,
+		}),
+	}}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	assert.False(t, plan.Set(ctx, &Command{
+		Triggers: types.MapNull(types.StringType),
+		Name:     types.StringValue("ApplicationIndexerSync"),
+		Body:     types.StringNull(),
+		Status:   types.StringUnknown(),
+		Message:  types.StringUnknown(),
+		Queued:   types.StringUnknown(),
+		Started:  types.StringUnknown(),
+		Ended:    types.StringUnknown(),
+		Duration: types.StringUnknown(),
+		ID:       types.Int64Unknown(),
+		Timeout:  types.Int64Value(10),
+	}).HasError())
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, resp)
+	assert.Equal(t, 1, resp.Diagnostics.ErrorsCount())
+
+	// the failure is recorded in state along with the error
+	var command Command
+
+	assert.False(t, resp.State.Get(ctx, &command).HasError())
+	assert.Equal(t, "failed", command.Status.ValueString())
+	assert.Equal(t, "Sync failed", command.Message.ValueString())
+}
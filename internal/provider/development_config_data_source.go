@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const developmentConfigDataSourceName = "development_config"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DevelopmentConfigDataSource{}
+
+func NewDevelopmentConfigDataSource() datasource.DataSource {
+	return &DevelopmentConfigDataSource{}
+}
+
+// DevelopmentConfigDataSource defines the development config implementation.
+type DevelopmentConfigDataSource struct {
+	data *ProwlarrData
+}
+
+func (d *DevelopmentConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + developmentConfigDataSourceName
+}
+
+func (d *DevelopmentConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:System -->\n[Development Config](../resources/development_config).",
+		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Development Config ID.",
+				Computed:            true,
+			},
+			"console_log_level": schema.StringAttribute{
+				MarkdownDescription: "Console log level.",
+				Computed:            true,
+			},
+			"log_sql": schema.BoolAttribute{
+				MarkdownDescription: "Log SQL flag.",
+				Computed:            true,
+			},
+			"log_indexer_response": schema.BoolAttribute{
+				MarkdownDescription: "Log indexer response flag.",
+				Computed:            true,
+			},
+			"log_rotate": schema.Int64Attribute{
+				MarkdownDescription: "Log rotation count.",
+				Computed:            true,
+			},
+			"filter_sentry_events": schema.BoolAttribute{
+				MarkdownDescription: "Filter sentry events flag.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DevelopmentConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.data = dataSourceConfigure(ctx, req, resp)
+}
+
+func (d *DevelopmentConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the instance from config
+	var name types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := d.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	// Get development config current value
+	response, _, err := instance.Client.DevelopmentConfigAPI.GetDevelopmentConfig(instance.Auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, developmentConfigDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+developmentConfigDataSourceName)
+
+	state := DevelopmentConfig{Instance: name}
+	state.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
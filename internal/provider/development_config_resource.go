@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const developmentConfigResourceName = "development_config"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &DevelopmentConfigResource{}
+	_ resource.ResourceWithImportState = &DevelopmentConfigResource{}
+)
+
+func NewDevelopmentConfigResource() resource.Resource {
+	return &DevelopmentConfigResource{}
+}
+
+// DevelopmentConfigResource defines the development config implementation.
+type DevelopmentConfigResource struct {
+	data *ProwlarrData
+}
+
+// DevelopmentConfig describes the development config data model.
+type DevelopmentConfig struct {
+	Instance           types.String `tfsdk:"instance"`
+	ConsoleLogLevel    types.String `tfsdk:"console_log_level"`
+	ID                 types.Int64  `tfsdk:"id"`
+	LogRotate          types.Int64  `tfsdk:"log_rotate"`
+	LogSQL             types.Bool   `tfsdk:"log_sql"`
+	LogIndexerResponse types.Bool   `tfsdk:"log_indexer_response"`
+	FilterSentryEvents types.Bool   `tfsdk:"filter_sentry_events"`
+}
+
+func (r *DevelopmentConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + developmentConfigResourceName
+}
+
+func (r *DevelopmentConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->\nDevelopment Config resource.\nFor more information refer to [Development](https://wiki.servarr.com/prowlarr/settings#general) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Development Config ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"console_log_level": schema.StringAttribute{
+				MarkdownDescription: "Console log level.",
+				Required:            true,
+			},
+			"log_sql": schema.BoolAttribute{
+				MarkdownDescription: "Log SQL flag.",
+				Required:            true,
+			},
+			"log_indexer_response": schema.BoolAttribute{
+				MarkdownDescription: "Log indexer response flag.",
+				Required:            true,
+			},
+			"log_rotate": schema.Int64Attribute{
+				MarkdownDescription: "Log rotation count.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"filter_sentry_events": schema.BoolAttribute{
+				MarkdownDescription: "Filter sentry events flag.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *DevelopmentConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.data = resourceConfigure(ctx, req, resp)
+}
+
+func (r *DevelopmentConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var config *DevelopmentConfig
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.data.instance(config.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	// Build Create resource
+	request := config.read()
+	request.SetId(1)
+
+	// Create new DevelopmentConfig
+	response, _, err := instance.Client.DevelopmentConfigAPI.UpdateDevelopmentConfig(instance.Auth, strconv.Itoa(int(request.GetId()))).DevelopmentConfigResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, developmentConfigResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+developmentConfigResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	config.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (r *DevelopmentConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var config *DevelopmentConfig
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.data.instance(config.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	// Get DevelopmentConfig current value
+	response, _, err := instance.Client.DevelopmentConfigAPI.GetDevelopmentConfig(instance.Auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, developmentConfigResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+developmentConfigResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	config.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (r *DevelopmentConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var config *DevelopmentConfig
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.data.instance(config.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	// Build Update resource
+	request := config.read()
+
+	// Update DevelopmentConfig
+	response, _, err := instance.Client.DevelopmentConfigAPI.UpdateDevelopmentConfig(instance.Auth, strconv.Itoa(int(request.GetId()))).DevelopmentConfigResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, developmentConfigResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+developmentConfigResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	config.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (r *DevelopmentConfigResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// DevelopmentConfig cannot be really deleted just removing configuration
+	tflog.Trace(ctx, "decoupled "+developmentConfigResourceName+": 1")
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *DevelopmentConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Only the `<instance>:` prefix of the identifier is used
+	importInstance(ctx, r.data, req.ID, resp)
+	tflog.Trace(ctx, "imported "+developmentConfigResourceName+": 1")
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), 1)...)
+}
+
+func (c *DevelopmentConfig) write(config *prowlarr.DevelopmentConfigResource) {
+	c.ID = types.Int64Value(int64(config.GetId()))
+	c.ConsoleLogLevel = types.StringValue(config.GetConsoleLogLevel())
+	c.LogSQL = types.BoolValue(config.GetLogSql())
+	c.LogIndexerResponse = types.BoolValue(config.GetLogIndexerResponse())
+	c.LogRotate = types.Int64Value(int64(config.GetLogRotate()))
+	c.FilterSentryEvents = types.BoolValue(config.GetFilterSentryEvents())
+}
+
+func (c *DevelopmentConfig) read() *prowlarr.DevelopmentConfigResource {
+	config := prowlarr.NewDevelopmentConfigResource()
+	config.SetId(int32(c.ID.ValueInt64()))
+	config.SetConsoleLogLevel(c.ConsoleLogLevel.ValueString())
+	config.SetLogSql(c.LogSQL.ValueBool())
+	config.SetLogIndexerResponse(c.LogIndexerResponse.ValueBool())
+	config.SetLogRotate(int32(c.LogRotate.ValueInt64()))
+	config.SetFilterSentryEvents(c.FilterSentryEvents.ValueBool())
+
+	return config
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDevelopmentConfigResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccDevelopmentConfigResourceConfig("false") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccDevelopmentConfigResourceConfig("false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_development_config.test", "log_sql", "false"),
+					resource.TestCheckResourceAttrSet("prowlarr_development_config.test", "id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccDevelopmentConfigResourceConfig("false") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccDevelopmentConfigResourceConfig("true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_development_config.test", "log_sql", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "prowlarr_development_config.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDevelopmentConfigResourceConfig(logSQL string) string {
+	return fmt.Sprintf(`
+	resource "prowlarr_development_config" "test" {
+		console_log_level = "debug"
+		log_sql = %s
+		log_indexer_response = false
+		log_rotate = 50
+		filter_sentry_events = true
+	}`, logSQL)
+}
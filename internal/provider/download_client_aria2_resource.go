@@ -35,12 +35,12 @@ func NewDownloadClientAria2Resource() resource.Resource {
 
 // DownloadClientAria2Resource defines the download client implementation.
 type DownloadClientAria2Resource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // DownloadClientAria2 describes the download client data model.
 type DownloadClientAria2 struct {
+	Instance    types.String `tfsdk:"instance"`
 	Tags        types.Set    `tfsdk:"tags"`
 	Categories  types.Set    `tfsdk:"categories"`
 	Name        types.String `tfsdk:"name"`
@@ -52,6 +52,7 @@ type DownloadClientAria2 struct {
 	ID          types.Int64  `tfsdk:"id"`
 	UseSsl      types.Bool   `tfsdk:"use_ssl"`
 	Enable      types.Bool   `tfsdk:"enable"`
+	TestOnApply types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientAria2) toDownloadClient() *DownloadClient {
@@ -95,6 +96,11 @@ func (r *DownloadClientAria2Resource) Schema(_ context.Context, _ resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client Aria2 resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/prowlarr/settings#download-clients) and [Aria2](https://wiki.servarr.com/prowlarr/supported#aria2).",
 		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Test the configuration against Prowlarr before applying it.",
+				Optional:            true,
+			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -160,10 +166,7 @@ func (r *DownloadClientAria2Resource) Schema(_ context.Context, _ resource.Schem
 }
 
 func (r *DownloadClientAria2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
-	}
+	r.data = resourceConfigure(ctx, req, resp)
 }
 
 func (r *DownloadClientAria2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -176,10 +179,27 @@ func (r *DownloadClientAria2Resource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	instance, err := r.data.instance(client.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Create new DownloadClientAria2
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	if client.TestOnApply.ValueBool() {
+		if _, err = instance.Client.DownloadClientAPI.TestDownloadClient(instance.Auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, downloadClientAria2ResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.DownloadClientAPI.CreateDownloadClient(instance.Auth).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientAria2ResourceName, err))
 
@@ -202,10 +222,17 @@ func (r *DownloadClientAria2Resource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	instance, err := r.data.instance(client.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get DownloadClientAria2 current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := instance.Client.DownloadClientAPI.GetDownloadClientById(instance.Auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientAria2ResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientAria2ResourceName, err, httpResp, resp)
 
 		return
 	}
@@ -226,10 +253,27 @@ func (r *DownloadClientAria2Resource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	instance, err := r.data.instance(client.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Update DownloadClientAria2
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+	if client.TestOnApply.ValueBool() {
+		if _, err = instance.Client.DownloadClientAPI.TestDownloadClient(instance.Auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, downloadClientAria2ResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.DownloadClientAPI.UpdateDownloadClient(instance.Auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientAria2ResourceName, err))
 
@@ -243,16 +287,27 @@ func (r *DownloadClientAria2Resource) Update(ctx context.Context, req resource.U
 }
 
 func (r *DownloadClientAria2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID   int64
+		name types.String
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	instance, err := r.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Delete DownloadClientAria2 current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err = instance.Client.DownloadClientAPI.DeleteDownloadClient(instance.Auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientAria2ResourceName, err))
 
@@ -264,6 +319,7 @@ func (r *DownloadClientAria2Resource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *DownloadClientAria2Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importInstance(ctx, r.data, req.ID, resp)
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
 	tflog.Trace(ctx, "imported "+downloadClientAria2ResourceName+": "+req.ID)
 }
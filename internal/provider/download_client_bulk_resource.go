@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const downloadClientBulkResourceName = "download_client_bulk"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DownloadClientBulkResource{}
+
+func NewDownloadClientBulkResource() resource.Resource {
+	return &DownloadClientBulkResource{}
+}
+
+// DownloadClientBulkResource defines the download client bulk implementation.
+type DownloadClientBulkResource struct {
+	data *ProwlarrData
+}
+
+// DownloadClientBulk describes the download client bulk data model.
+type DownloadClientBulk struct {
+	Instance  types.String `tfsdk:"instance"`
+	IDs       types.Set    `tfsdk:"ids"`
+	Tags      types.Set    `tfsdk:"tags"`
+	ApplyTags types.String `tfsdk:"apply_tags"`
+	ID        types.String `tfsdk:"id"`
+	Priority  types.Int64  `tfsdk:"priority"`
+	Enable    types.Bool   `tfsdk:"enable"`
+}
+
+func (r *DownloadClientBulkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + downloadClientBulkResourceName
+}
+
+func (r *DownloadClientBulkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client Bulk resource.\nApply the same partial update to a set of [Download Clients](../resources/download_client) in a single call. Only the configured attributes are managed and checked for drift.\nDestroying the resource leaves the download clients as they are.\nFor more information refer to [Download Clients](https://wiki.servarr.com/prowlarr/settings#download-clients) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Download Client Bulk ID, the sorted list of download client IDs.",
+				Computed:            true,
+			},
+			"ids": schema.SetAttribute{
+				MarkdownDescription: "Download Client IDs.",
+				Required:            true,
+				ElementType:         types.Int64Type,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"enable": schema.BoolAttribute{
+				MarkdownDescription: "Enable flag.",
+				Optional:            true,
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "Priority.",
+				Optional:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List of associated tags.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"apply_tags": schema.StringAttribute{
+				MarkdownDescription: "How tags are applied. Valid values are `add`, `remove` and `replace`. Defaults to `add`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(helpers.ApplyTagsAdd),
+				Validators: []validator.String{
+					stringvalidator.OneOf(helpers.ApplyTagsAdd, helpers.ApplyTagsRemove, helpers.ApplyTagsReplace),
+				},
+			},
+		},
+	}
+}
+
+func (r *DownloadClientBulkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.data = resourceConfigure(ctx, req, resp)
+}
+
+func (r *DownloadClientBulkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var bulk *DownloadClientBulk
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &bulk)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.data.instance(bulk.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	r.apply(ctx, instance, bulk, helpers.Create, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created "+downloadClientBulkResourceName+": "+bulk.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &bulk)...)
+}
+
+func (r *DownloadClientBulkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var bulk *DownloadClientBulk
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &bulk)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.data.instance(bulk.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	// Get download clients current value
+	response, _, err := instance.Client.DownloadClientAPI.ListDownloadClient(instance.Auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientBulkResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+downloadClientBulkResourceName+": "+bulk.ID.ValueString())
+	// Map response body to resource schema attribute
+	found := bulk.write(ctx, response, &resp.Diagnostics)
+	// Objects deleted outside of Terraform are reported as drift on ids
+	bulk.IDs, bulk.ID = writeBulkIDs(ctx, found, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &bulk)...)
+}
+
+func (r *DownloadClientBulkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var bulk *DownloadClientBulk
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &bulk)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.data.instance(bulk.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	r.apply(ctx, instance, bulk, helpers.Update, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+downloadClientBulkResourceName+": "+bulk.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &bulk)...)
+}
+
+func (r *DownloadClientBulkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var bulk *DownloadClientBulk
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &bulk)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bulk edits cannot be reverted just removing them from state
+	tflog.Trace(ctx, "decoupled "+downloadClientBulkResourceName+": "+bulk.ID.ValueString())
+	resp.State.RemoveResource(ctx)
+}
+
+// apply sends the bulk edit and refreshes the given model.
+func (r *DownloadClientBulkResource) apply(ctx context.Context, instance *ProwlarrData, bulk *DownloadClientBulk, action string, diags *diag.Diagnostics) {
+	request := bulk.read(ctx, diags)
+	if diags.HasError() {
+		return
+	}
+
+	if _, _, err := instance.Client.DownloadClientAPI.PutDownloadClientBulk(instance.Auth).DownloadClientBulkResource(*request).Execute(); err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, downloadClientBulkResourceName, err))
+
+		return
+	}
+
+	response, _, err := instance.Client.DownloadClientAPI.ListDownloadClient(instance.Auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, downloadClientBulkResourceName, err))
+
+		return
+	}
+
+	found := bulk.write(ctx, response, diags)
+	// The configured ids are kept in state, so missing objects fail the apply
+	checkBulkIDs(ctx, downloadClientResourceName, bulk.IDs, found, diags)
+	_, bulk.ID = writeBulkIDs(ctx, found, diags)
+}
+
+// write refreshes the managed attributes and returns the IDs of the existing objects.
+func (b *DownloadClientBulk) write(ctx context.Context, downloadClients []prowlarr.DownloadClientResource, diags *diag.Diagnostics) []int32 {
+	ids := make([]int32, 0, len(b.IDs.Elements()))
+	diags.Append(b.IDs.ElementsAs(ctx, &ids, false)...)
+
+	found := make([]int32, 0, len(ids))
+	enable := make([]bool, 0, len(ids))
+	priorities := make([]int64, 0, len(ids))
+	tags := make([][]int32, 0, len(ids))
+
+	// Only the download clients in the bulk still existing are considered
+	for _, d := range downloadClients {
+		if !slices.Contains(ids, d.GetId()) {
+			continue
+		}
+
+		var client DownloadClient
+
+		client.write(ctx, &d, diags)
+
+		clientTags := make([]int32, 0, len(client.Tags.Elements()))
+		diags.Append(client.Tags.ElementsAs(ctx, &clientTags, false)...)
+
+		found = append(found, int32(client.ID.ValueInt64()))
+		enable = append(enable, client.Enable.ValueBool())
+		priorities = append(priorities, client.Priority.ValueInt64())
+		tags = append(tags, clientTags)
+	}
+
+	b.Tags = writeBulkTags(ctx, b.Tags, b.ApplyTags.ValueString(), tags, diags)
+
+	if !b.Enable.IsNull() {
+		b.Enable = types.BoolValue(helpers.BulkValue(b.Enable.ValueBool(), enable))
+	}
+
+	if !b.Priority.IsNull() {
+		b.Priority = types.Int64Value(helpers.BulkValue(b.Priority.ValueInt64(), priorities))
+	}
+
+	return found
+}
+
+func (b *DownloadClientBulk) read(ctx context.Context, diags *diag.Diagnostics) *prowlarr.DownloadClientBulkResource {
+	bulk := prowlarr.NewDownloadClientBulkResource()
+
+	ids := make([]int32, 0, len(b.IDs.Elements()))
+	diags.Append(b.IDs.ElementsAs(ctx, &ids, false)...)
+	bulk.SetIds(ids)
+
+	if !b.Tags.IsNull() {
+		tags := make([]int32, 0, len(b.Tags.Elements()))
+		diags.Append(b.Tags.ElementsAs(ctx, &tags, false)...)
+		bulk.SetTags(tags)
+		bulk.SetApplyTags(prowlarr.ApplyTags(b.ApplyTags.ValueString()))
+	}
+
+	if !b.Enable.IsNull() {
+		bulk.SetEnable(b.Enable.ValueBool())
+	}
+
+	if !b.Priority.IsNull() {
+		bulk.SetPriority(int32(b.Priority.ValueInt64()))
+	}
+
+	return bulk
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDownloadClientBulkResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccDownloadClientBulkResourceConfig(10) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccDownloadClientBulkResourceConfig(10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_download_client_bulk.test", "priority", "10"),
+					resource.TestCheckResourceAttrSet("prowlarr_download_client_bulk.test", "id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccDownloadClientBulkResourceConfig(10) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccDownloadClientBulkResourceConfig(20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_download_client_bulk.test", "priority", "20"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDownloadClientBulkResourceConfig(priority int) string {
+	return fmt.Sprintf(`
+	resource "prowlarr_tag" "bulk" {
+		label = "downloadclientbulk"
+	}
+
+	resource "prowlarr_download_client" "bulk" {
+		enable = false
+		priority = 1
+		name = "BulkTest"
+		implementation = "Transmission"
+		protocol = "torrent"
+		config_contract = "TransmissionSettings"
+		host = "transmission"
+		url_base = "/transmission/"
+		port = 9091
+
+		lifecycle {
+			ignore_changes = [enable, priority, tags]
+		}
+	}
+
+	resource "prowlarr_download_client_bulk" "test" {
+		ids = [prowlarr_download_client.bulk.id]
+		enable = false
+		priority = %d
+		tags = [prowlarr_tag.bulk.id]
+	}`, priority)
+}
@@ -5,10 +5,10 @@ import (
 
 	"github.com/devopsarr/prowlarr-go/prowlarr"
 	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
-
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +24,13 @@ func NewDownloadClientDataSource() datasource.DataSource {
 
 // DownloadClientDataSource defines the download_client implementation.
 type DownloadClientDataSource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
+}
+
+// InstanceDownloadClient extends DownloadClient with the instance attribute, which the list elements do not have.
+type InstanceDownloadClient struct {
+	Instance types.String `tfsdk:"instance"`
+	DownloadClient
 }
 
 func (d *DownloadClientDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -37,6 +42,7 @@ func (d *DownloadClientDataSource) Schema(_ context.Context, _ datasource.Schema
 		// This description is used by the documentation generator and the delay server.
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nSingle [Download Client](../resources/download_client).",
 		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Computed:            true,
@@ -232,22 +238,26 @@ func (d *DownloadClientDataSource) Schema(_ context.Context, _ datasource.Schema
 }
 
 func (d *DownloadClientDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
-		d.client = client
-		d.auth = auth
-	}
+	d.data = dataSourceConfigure(ctx, req, resp)
 }
 
 func (d *DownloadClientDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data *DownloadClient
+	var data *InstanceDownloadClient
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	instance, err := d.data.instance(data.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
 	// Get downloadClient current value
-	response, _, err := d.client.DownloadClientAPI.ListDownloadClient(d.auth).Execute()
+	response, _, err := instance.Client.DownloadClientAPI.ListDownloadClient(instance.Auth).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientDataSourceName, err))
 
@@ -37,26 +37,29 @@ func NewDownloadClientDelugeResource() resource.Resource {
 
 // DownloadClientDelugeResource defines the download client implementation.
 type DownloadClientDelugeResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // DownloadClientDeluge describes the download client data model.
 type DownloadClientDeluge struct {
-	Tags         types.Set    `tfsdk:"tags"`
-	Categories   types.Set    `tfsdk:"categories"`
-	Name         types.String `tfsdk:"name"`
-	Host         types.String `tfsdk:"host"`
-	URLBase      types.String `tfsdk:"url_base"`
-	Password     types.String `tfsdk:"password"`
-	Category     types.String `tfsdk:"category"`
-	ItemPriority types.Int64  `tfsdk:"item_priority"`
-	Priority     types.Int64  `tfsdk:"priority"`
-	Port         types.Int64  `tfsdk:"port"`
-	ID           types.Int64  `tfsdk:"id"`
-	AddPaused    types.Bool   `tfsdk:"add_paused"`
-	UseSsl       types.Bool   `tfsdk:"use_ssl"`
-	Enable       types.Bool   `tfsdk:"enable"`
+	Instance          types.String `tfsdk:"instance"`
+	Tags              types.Set    `tfsdk:"tags"`
+	Categories        types.Set    `tfsdk:"categories"`
+	Name              types.String `tfsdk:"name"`
+	Host              types.String `tfsdk:"host"`
+	URLBase           types.String `tfsdk:"url_base"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	Category          types.String `tfsdk:"category"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	ItemPriority      types.Int64  `tfsdk:"item_priority"`
+	Priority          types.Int64  `tfsdk:"priority"`
+	Port              types.Int64  `tfsdk:"port"`
+	ID                types.Int64  `tfsdk:"id"`
+	AddPaused         types.Bool   `tfsdk:"add_paused"`
+	UseSsl            types.Bool   `tfsdk:"use_ssl"`
+	Enable            types.Bool   `tfsdk:"enable"`
+	TestOnApply       types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientDeluge) toDownloadClient() *DownloadClient {
@@ -106,6 +109,11 @@ func (r *DownloadClientDelugeResource) Schema(_ context.Context, _ resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client Deluge resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/prowlarr/settings#download-clients) and [Deluge](https://wiki.servarr.com/prowlarr/supported#deluge).",
 		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Test the configuration against Prowlarr before applying it.",
+				Optional:            true,
+			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -186,15 +194,14 @@ func (r *DownloadClientDelugeResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 				Sensitive:           true,
 			},
+			"password_wo":         writeOnlyAttribute("password", "Password."),
+			"password_wo_version": writeOnlyVersionAttribute("password_wo"),
 		},
 	}
 }
 
 func (r *DownloadClientDelugeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
-	}
+	r.data = resourceConfigure(ctx, req, resp)
 }
 
 func (r *DownloadClientDelugeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -207,10 +214,28 @@ func (r *DownloadClientDelugeResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	instance, err := r.data.instance(client.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Create new DownloadClientDeluge
 	request := client.read(ctx, &resp.Diagnostics)
+	request.SetFields(readWriteOnlyFields(ctx, req.Config, request.GetFields(), downloadClientWriteOnlyFields, &resp.Diagnostics))
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	if client.TestOnApply.ValueBool() {
+		if _, err = instance.Client.DownloadClientAPI.TestDownloadClient(instance.Auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, downloadClientDelugeResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.DownloadClientAPI.CreateDownloadClient(instance.Auth).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientDelugeResourceName, err))
 
@@ -233,10 +258,17 @@ func (r *DownloadClientDelugeResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	instance, err := r.data.instance(client.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get DownloadClientDeluge current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := instance.Client.DownloadClientAPI.GetDownloadClientById(instance.Auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientDelugeResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientDelugeResourceName, err, httpResp, resp)
 
 		return
 	}
@@ -257,10 +289,28 @@ func (r *DownloadClientDelugeResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	instance, err := r.data.instance(client.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Update DownloadClientDeluge
 	request := client.read(ctx, &resp.Diagnostics)
+	request.SetFields(readWriteOnlyFields(ctx, req.Config, request.GetFields(), downloadClientWriteOnlyFields, &resp.Diagnostics))
+
+	if client.TestOnApply.ValueBool() {
+		if _, err = instance.Client.DownloadClientAPI.TestDownloadClient(instance.Auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, downloadClientDelugeResourceName, err, req.Plan, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.DownloadClientAPI.UpdateDownloadClient(instance.Auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientDelugeResourceName, err))
 
@@ -274,16 +324,27 @@ func (r *DownloadClientDelugeResource) Update(ctx context.Context, req resource.
 }
 
 func (r *DownloadClientDelugeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID   int64
+		name types.String
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	instance, err := r.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Delete DownloadClientDeluge current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err = instance.Client.DownloadClientAPI.DeleteDownloadClient(instance.Auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientDelugeResourceName, err))
 
@@ -295,6 +356,7 @@ func (r *DownloadClientDelugeResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *DownloadClientDelugeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importInstance(ctx, r.data, req.ID, resp)
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
 	tflog.Trace(ctx, "imported "+downloadClientDelugeResourceName+": "+req.ID)
 }
@@ -35,28 +35,31 @@ func NewDownloadClientFloodResource() resource.Resource {
 
 // DownloadClientFloodResource defines the download client implementation.
 type DownloadClientFloodResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // DownloadClientFlood describes the download client data model.
 type DownloadClientFlood struct {
-	Tags           types.Set    `tfsdk:"tags"`
-	Categories     types.Set    `tfsdk:"categories"`
-	FieldTags      types.Set    `tfsdk:"field_tags"`
-	AdditionalTags types.Set    `tfsdk:"additional_tags"`
-	Name           types.String `tfsdk:"name"`
-	Host           types.String `tfsdk:"host"`
-	URLBase        types.String `tfsdk:"url_base"`
-	Username       types.String `tfsdk:"username"`
-	Password       types.String `tfsdk:"password"`
-	Destination    types.String `tfsdk:"destination"`
-	Priority       types.Int64  `tfsdk:"priority"`
-	Port           types.Int64  `tfsdk:"port"`
-	ID             types.Int64  `tfsdk:"id"`
-	AddPaused      types.Bool   `tfsdk:"add_paused"`
-	UseSsl         types.Bool   `tfsdk:"use_ssl"`
-	Enable         types.Bool   `tfsdk:"enable"`
+	Instance          types.String `tfsdk:"instance"`
+	Tags              types.Set    `tfsdk:"tags"`
+	Categories        types.Set    `tfsdk:"categories"`
+	FieldTags         types.Set    `tfsdk:"field_tags"`
+	AdditionalTags    types.Set    `tfsdk:"additional_tags"`
+	Name              types.String `tfsdk:"name"`
+	Host              types.String `tfsdk:"host"`
+	URLBase           types.String `tfsdk:"url_base"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	Destination       types.String `tfsdk:"destination"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	Priority          types.Int64  `tfsdk:"priority"`
+	Port              types.Int64  `tfsdk:"port"`
+	ID                types.Int64  `tfsdk:"id"`
+	AddPaused         types.Bool   `tfsdk:"add_paused"`
+	UseSsl            types.Bool   `tfsdk:"use_ssl"`
+	Enable            types.Bool   `tfsdk:"enable"`
+	TestOnApply       types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientFlood) toDownloadClient() *DownloadClient {
@@ -110,6 +113,11 @@ func (r *DownloadClientFloodResource) Schema(_ context.Context, _ resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client Flood resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/prowlarr/settings#download-clients) and [Flood](https://wiki.servarr.com/prowlarr/supported#flood).",
 		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Test the configuration against Prowlarr before applying it.",
+				Optional:            true,
+			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -182,6 +190,8 @@ func (r *DownloadClientFloodResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				Sensitive:           true,
 			},
+			"password_wo":         writeOnlyAttribute("password", "Password."),
+			"password_wo_version": writeOnlyVersionAttribute("password_wo"),
 			"destination": schema.StringAttribute{
 				MarkdownDescription: "Destination.",
 				Optional:            true,
@@ -204,10 +214,7 @@ func (r *DownloadClientFloodResource) Schema(_ context.Context, _ resource.Schem
 }
 
 func (r *DownloadClientFloodResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
-	}
+	r.data = resourceConfigure(ctx, req, resp)
 }
 
 func (r *DownloadClientFloodResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -220,10 +227,28 @@ func (r *DownloadClientFloodResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	instance, err := r.data.instance(client.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Create new DownloadClientFlood
 	request := client.read(ctx, &resp.Diagnostics)
+	request.SetFields(readWriteOnlyFields(ctx, req.Config, request.GetFields(), downloadClientWriteOnlyFields, &resp.Diagnostics))
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	if client.TestOnApply.ValueBool() {
+		if _, err = instance.Client.DownloadClientAPI.TestDownloadClient(instance.Auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, downloadClientFloodResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.DownloadClientAPI.CreateDownloadClient(instance.Auth).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientFloodResourceName, err))
 
@@ -246,10 +271,17 @@ func (r *DownloadClientFloodResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	instance, err := r.data.instance(client.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get DownloadClientFlood current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := instance.Client.DownloadClientAPI.GetDownloadClientById(instance.Auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientFloodResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientFloodResourceName, err, httpResp, resp)
 
 		return
 	}
@@ -270,10 +302,28 @@ func (r *DownloadClientFloodResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	instance, err := r.data.instance(client.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Update DownloadClientFlood
 	request := client.read(ctx, &resp.Diagnostics)
+	request.SetFields(readWriteOnlyFields(ctx, req.Config, request.GetFields(), downloadClientWriteOnlyFields, &resp.Diagnostics))
+
+	if client.TestOnApply.ValueBool() {
+		if _, err = instance.Client.DownloadClientAPI.TestDownloadClient(instance.Auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, downloadClientFloodResourceName, err, req.Plan, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.DownloadClientAPI.UpdateDownloadClient(instance.Auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientFloodResourceName, err))
 
@@ -287,16 +337,27 @@ func (r *DownloadClientFloodResource) Update(ctx context.Context, req resource.U
 }
 
 func (r *DownloadClientFloodResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID   int64
+		name types.String
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	instance, err := r.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Delete DownloadClientFlood current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err = instance.Client.DownloadClientAPI.DeleteDownloadClient(instance.Auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientFloodResourceName, err))
 
@@ -308,6 +369,7 @@ func (r *DownloadClientFloodResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *DownloadClientFloodResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importInstance(ctx, r.data, req.ID, resp)
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
 	tflog.Trace(ctx, "imported "+downloadClientFloodResourceName+": "+req.ID)
 }
@@ -37,12 +37,12 @@ func NewDownloadClientFreeboxResource() resource.Resource {
 
 // DownloadClientFreeboxResource defines the download client implementation.
 type DownloadClientFreeboxResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // DownloadClientFreebox describes the download client data model.
 type DownloadClientFreebox struct {
+	Instance             types.String `tfsdk:"instance"`
 	Tags                 types.Set    `tfsdk:"tags"`
 	Categories           types.Set    `tfsdk:"categories"`
 	Name                 types.String `tfsdk:"name"`
@@ -59,6 +59,7 @@ type DownloadClientFreebox struct {
 	AddPaused            types.Bool   `tfsdk:"add_paused"`
 	UseSsl               types.Bool   `tfsdk:"use_ssl"`
 	Enable               types.Bool   `tfsdk:"enable"`
+	TestOnApply          types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientFreebox) toDownloadClient() *DownloadClient {
@@ -112,6 +113,11 @@ func (r *DownloadClientFreeboxResource) Schema(_ context.Context, _ resource.Sch
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client Freebox resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/prowlarr/settings#download-clients) and [Freebox](https://wiki.servarr.com/prowlarr/supported#torrentfreeboxdownload).",
 		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Test the configuration against Prowlarr before applying it.",
+				Optional:            true,
+			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -202,10 +208,7 @@ func (r *DownloadClientFreeboxResource) Schema(_ context.Context, _ resource.Sch
 }
 
 func (r *DownloadClientFreeboxResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
-	}
+	r.data = resourceConfigure(ctx, req, resp)
 }
 
 func (r *DownloadClientFreeboxResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -218,10 +221,27 @@ func (r *DownloadClientFreeboxResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	instance, err := r.data.instance(client.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Create new DownloadClientFreebox
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	if client.TestOnApply.ValueBool() {
+		if _, err = instance.Client.DownloadClientAPI.TestDownloadClient(instance.Auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, downloadClientFreeboxResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.DownloadClientAPI.CreateDownloadClient(instance.Auth).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientFreeboxResourceName, err))
 
@@ -244,10 +264,17 @@ func (r *DownloadClientFreeboxResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
+	instance, err := r.data.instance(client.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get DownloadClientFreebox current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := instance.Client.DownloadClientAPI.GetDownloadClientById(instance.Auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientFreeboxResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientFreeboxResourceName, err, httpResp, resp)
 
 		return
 	}
@@ -268,10 +295,27 @@ func (r *DownloadClientFreeboxResource) Update(ctx context.Context, req resource
 		return
 	}
 
+	instance, err := r.data.instance(client.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Update DownloadClientFreebox
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+	if client.TestOnApply.ValueBool() {
+		if _, err = instance.Client.DownloadClientAPI.TestDownloadClient(instance.Auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, downloadClientFreeboxResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.DownloadClientAPI.UpdateDownloadClient(instance.Auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientFreeboxResourceName, err))
 
@@ -285,16 +329,27 @@ func (r *DownloadClientFreeboxResource) Update(ctx context.Context, req resource
 }
 
 func (r *DownloadClientFreeboxResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID   int64
+		name types.String
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	instance, err := r.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Delete DownloadClientFreebox current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err = instance.Client.DownloadClientAPI.DeleteDownloadClient(instance.Auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientFreeboxResourceName, err))
 
@@ -306,6 +361,7 @@ func (r *DownloadClientFreeboxResource) Delete(ctx context.Context, req resource
 }
 
 func (r *DownloadClientFreeboxResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importInstance(ctx, r.data, req.ID, resp)
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
 	tflog.Trace(ctx, "imported "+downloadClientFreeboxResourceName+": "+req.ID)
 }
@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/prowlarr-go/prowlarr"
 	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -35,25 +37,28 @@ func NewDownloadClientHadoukenResource() resource.Resource {
 
 // DownloadClientHadoukenResource defines the download client implementation.
 type DownloadClientHadoukenResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // DownloadClientHadouken describes the download client data model.
 type DownloadClientHadouken struct {
-	Tags       types.Set    `tfsdk:"tags"`
-	Categories types.Set    `tfsdk:"categories"`
-	Name       types.String `tfsdk:"name"`
-	Host       types.String `tfsdk:"host"`
-	URLBase    types.String `tfsdk:"url_base"`
-	Username   types.String `tfsdk:"username"`
-	Password   types.String `tfsdk:"password"`
-	Category   types.String `tfsdk:"category"`
-	Priority   types.Int64  `tfsdk:"priority"`
-	Port       types.Int64  `tfsdk:"port"`
-	ID         types.Int64  `tfsdk:"id"`
-	UseSsl     types.Bool   `tfsdk:"use_ssl"`
-	Enable     types.Bool   `tfsdk:"enable"`
+	Instance          types.String `tfsdk:"instance"`
+	Tags              types.Set    `tfsdk:"tags"`
+	Categories        types.Set    `tfsdk:"categories"`
+	Name              types.String `tfsdk:"name"`
+	Host              types.String `tfsdk:"host"`
+	URLBase           types.String `tfsdk:"url_base"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	Category          types.String `tfsdk:"category"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	Priority          types.Int64  `tfsdk:"priority"`
+	Port              types.Int64  `tfsdk:"port"`
+	ID                types.Int64  `tfsdk:"id"`
+	UseSsl            types.Bool   `tfsdk:"use_ssl"`
+	Enable            types.Bool   `tfsdk:"enable"`
+	TestOnApply       types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientHadouken) toDownloadClient() *DownloadClient {
@@ -101,6 +106,11 @@ func (r *DownloadClientHadoukenResource) Schema(_ context.Context, _ resource.Sc
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client Hadouken resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/prowlarr/settings#download-clients) and [Hadouken](https://wiki.servarr.com/prowlarr/supported#hadouken).",
 		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Test the configuration against Prowlarr before applying it.",
+				Optional:            true,
+			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -163,9 +173,15 @@ func (r *DownloadClientHadoukenResource) Schema(_ context.Context, _ resource.Sc
 			},
 			"password": schema.StringAttribute{
 				MarkdownDescription: "Password.",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
 				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("password_wo")),
+				},
 			},
+			"password_wo":         writeOnlyAttribute("password", "Password."),
+			"password_wo_version": writeOnlyVersionAttribute("password_wo"),
 			"category": schema.StringAttribute{
 				MarkdownDescription: "Category.",
 				Optional:            true,
@@ -176,10 +192,7 @@ func (r *DownloadClientHadoukenResource) Schema(_ context.Context, _ resource.Sc
 }
 
 func (r *DownloadClientHadoukenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
-	}
+	r.data = resourceConfigure(ctx, req, resp)
 }
 
 func (r *DownloadClientHadoukenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -192,10 +205,28 @@ func (r *DownloadClientHadoukenResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	instance, err := r.data.instance(client.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Create new DownloadClientHadouken
 	request := client.read(ctx, &resp.Diagnostics)
+	request.SetFields(readWriteOnlyFields(ctx, req.Config, request.GetFields(), downloadClientWriteOnlyFields, &resp.Diagnostics))
+
+	if client.TestOnApply.ValueBool() {
+		if _, err = instance.Client.DownloadClientAPI.TestDownloadClient(instance.Auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, downloadClientHadoukenResourceName, err, req.Plan, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.DownloadClientAPI.CreateDownloadClient(instance.Auth).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientHadoukenResourceName, err))
 
@@ -218,10 +249,17 @@ func (r *DownloadClientHadoukenResource) Read(ctx context.Context, req resource.
 		return
 	}
 
+	instance, err := r.data.instance(client.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get DownloadClientHadouken current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := instance.Client.DownloadClientAPI.GetDownloadClientById(instance.Auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientHadoukenResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientHadoukenResourceName, err, httpResp, resp)
 
 		return
 	}
@@ -242,10 +280,28 @@ func (r *DownloadClientHadoukenResource) Update(ctx context.Context, req resourc
 		return
 	}
 
+	instance, err := r.data.instance(client.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Update DownloadClientHadouken
 	request := client.read(ctx, &resp.Diagnostics)
+	request.SetFields(readWriteOnlyFields(ctx, req.Config, request.GetFields(), downloadClientWriteOnlyFields, &resp.Diagnostics))
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+	if client.TestOnApply.ValueBool() {
+		if _, err = instance.Client.DownloadClientAPI.TestDownloadClient(instance.Auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, downloadClientHadoukenResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.DownloadClientAPI.UpdateDownloadClient(instance.Auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientHadoukenResourceName, err))
 
@@ -259,16 +315,27 @@ func (r *DownloadClientHadoukenResource) Update(ctx context.Context, req resourc
 }
 
 func (r *DownloadClientHadoukenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID   int64
+		name types.String
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	instance, err := r.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Delete DownloadClientHadouken current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err = instance.Client.DownloadClientAPI.DeleteDownloadClient(instance.Auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientHadoukenResourceName, err))
 
@@ -280,6 +347,7 @@ func (r *DownloadClientHadoukenResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *DownloadClientHadoukenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importInstance(ctx, r.data, req.ID, resp)
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
 	tflog.Trace(ctx, "imported "+downloadClientHadoukenResourceName+": "+req.ID)
 }
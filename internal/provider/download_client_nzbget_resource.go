@@ -37,27 +37,30 @@ func NewDownloadClientNzbgetResource() resource.Resource {
 
 // DownloadClientNzbgetResource defines the download client implementation.
 type DownloadClientNzbgetResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // DownloadClientNzbget describes the download client data model.
 type DownloadClientNzbget struct {
-	Tags         types.Set    `tfsdk:"tags"`
-	Categories   types.Set    `tfsdk:"categories"`
-	Name         types.String `tfsdk:"name"`
-	Host         types.String `tfsdk:"host"`
-	URLBase      types.String `tfsdk:"url_base"`
-	Username     types.String `tfsdk:"username"`
-	Password     types.String `tfsdk:"password"`
-	Category     types.String `tfsdk:"category"`
-	ItemPriority types.Int64  `tfsdk:"item_priority"`
-	Priority     types.Int64  `tfsdk:"priority"`
-	Port         types.Int64  `tfsdk:"port"`
-	ID           types.Int64  `tfsdk:"id"`
-	AddPaused    types.Bool   `tfsdk:"add_paused"`
-	UseSsl       types.Bool   `tfsdk:"use_ssl"`
-	Enable       types.Bool   `tfsdk:"enable"`
+	Instance          types.String `tfsdk:"instance"`
+	Tags              types.Set    `tfsdk:"tags"`
+	Categories        types.Set    `tfsdk:"categories"`
+	Name              types.String `tfsdk:"name"`
+	Host              types.String `tfsdk:"host"`
+	URLBase           types.String `tfsdk:"url_base"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	Category          types.String `tfsdk:"category"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	ItemPriority      types.Int64  `tfsdk:"item_priority"`
+	Priority          types.Int64  `tfsdk:"priority"`
+	Port              types.Int64  `tfsdk:"port"`
+	ID                types.Int64  `tfsdk:"id"`
+	AddPaused         types.Bool   `tfsdk:"add_paused"`
+	UseSsl            types.Bool   `tfsdk:"use_ssl"`
+	Enable            types.Bool   `tfsdk:"enable"`
+	TestOnApply       types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientNzbget) toDownloadClient() *DownloadClient {
@@ -109,6 +112,11 @@ func (r *DownloadClientNzbgetResource) Schema(_ context.Context, _ resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client NZBGet resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/prowlarr/settings#download-clients) and [NZBGet](https://wiki.servarr.com/prowlarr/supported#nzbget).",
 		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Test the configuration against Prowlarr before applying it.",
+				Optional:            true,
+			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -189,6 +197,8 @@ func (r *DownloadClientNzbgetResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 				Sensitive:           true,
 			},
+			"password_wo":         writeOnlyAttribute("password", "Password."),
+			"password_wo_version": writeOnlyVersionAttribute("password_wo"),
 			"category": schema.StringAttribute{
 				MarkdownDescription: "Category.",
 				Optional:            true,
@@ -199,10 +209,7 @@ func (r *DownloadClientNzbgetResource) Schema(_ context.Context, _ resource.Sche
 }
 
 func (r *DownloadClientNzbgetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
-	}
+	r.data = resourceConfigure(ctx, req, resp)
 }
 
 func (r *DownloadClientNzbgetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -215,10 +222,28 @@ func (r *DownloadClientNzbgetResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	instance, err := r.data.instance(client.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Create new DownloadClientNzbget
 	request := client.read(ctx, &resp.Diagnostics)
+	request.SetFields(readWriteOnlyFields(ctx, req.Config, request.GetFields(), downloadClientWriteOnlyFields, &resp.Diagnostics))
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	if client.TestOnApply.ValueBool() {
+		if _, err = instance.Client.DownloadClientAPI.TestDownloadClient(instance.Auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, downloadClientNzbgetResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.DownloadClientAPI.CreateDownloadClient(instance.Auth).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientNzbgetResourceName, err))
 
@@ -241,10 +266,17 @@ func (r *DownloadClientNzbgetResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	instance, err := r.data.instance(client.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get DownloadClientNzbget current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := instance.Client.DownloadClientAPI.GetDownloadClientById(instance.Auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientNzbgetResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientNzbgetResourceName, err, httpResp, resp)
 
 		return
 	}
@@ -265,10 +297,28 @@ func (r *DownloadClientNzbgetResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	instance, err := r.data.instance(client.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Update DownloadClientNzbget
 	request := client.read(ctx, &resp.Diagnostics)
+	request.SetFields(readWriteOnlyFields(ctx, req.Config, request.GetFields(), downloadClientWriteOnlyFields, &resp.Diagnostics))
+
+	if client.TestOnApply.ValueBool() {
+		if _, err = instance.Client.DownloadClientAPI.TestDownloadClient(instance.Auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, downloadClientNzbgetResourceName, err, req.Plan, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.DownloadClientAPI.UpdateDownloadClient(instance.Auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientNzbgetResourceName, err))
 
@@ -282,16 +332,27 @@ func (r *DownloadClientNzbgetResource) Update(ctx context.Context, req resource.
 }
 
 func (r *DownloadClientNzbgetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID   int64
+		name types.String
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	instance, err := r.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Delete DownloadClientNzbget current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err = instance.Client.DownloadClientAPI.DeleteDownloadClient(instance.Auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientNzbgetResourceName, err))
 
@@ -303,6 +364,7 @@ func (r *DownloadClientNzbgetResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *DownloadClientNzbgetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importInstance(ctx, r.data, req.ID, resp)
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
 	tflog.Trace(ctx, "imported "+downloadClientNzbgetResourceName+": "+req.ID)
 }
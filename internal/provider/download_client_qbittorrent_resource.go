@@ -39,27 +39,30 @@ func NewDownloadClientQbittorrentResource() resource.Resource {
 
 // DownloadClientQbittorrentResource defines the download client implementation.
 type DownloadClientQbittorrentResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // DownloadClientQbittorrent describes the download client data model.
 type DownloadClientQbittorrent struct {
-	Tags         types.Set    `tfsdk:"tags"`
-	Categories   types.Set    `tfsdk:"categories"`
-	Name         types.String `tfsdk:"name"`
-	Host         types.String `tfsdk:"host"`
-	URLBase      types.String `tfsdk:"url_base"`
-	Username     types.String `tfsdk:"username"`
-	Password     types.String `tfsdk:"password"`
-	Category     types.String `tfsdk:"category"`
-	ItemPriority types.Int64  `tfsdk:"item_priority"`
-	Priority     types.Int64  `tfsdk:"priority"`
-	Port         types.Int64  `tfsdk:"port"`
-	ID           types.Int64  `tfsdk:"id"`
-	InitialState types.Int64  `tfsdk:"initial_state"`
-	UseSsl       types.Bool   `tfsdk:"use_ssl"`
-	Enable       types.Bool   `tfsdk:"enable"`
+	Instance          types.String `tfsdk:"instance"`
+	Tags              types.Set    `tfsdk:"tags"`
+	Categories        types.Set    `tfsdk:"categories"`
+	Name              types.String `tfsdk:"name"`
+	Host              types.String `tfsdk:"host"`
+	URLBase           types.String `tfsdk:"url_base"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	Category          types.String `tfsdk:"category"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	ItemPriority      types.Int64  `tfsdk:"item_priority"`
+	Priority          types.Int64  `tfsdk:"priority"`
+	Port              types.Int64  `tfsdk:"port"`
+	ID                types.Int64  `tfsdk:"id"`
+	InitialState      types.Int64  `tfsdk:"initial_state"`
+	UseSsl            types.Bool   `tfsdk:"use_ssl"`
+	Enable            types.Bool   `tfsdk:"enable"`
+	TestOnApply       types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientQbittorrent) toDownloadClient() *DownloadClient {
@@ -111,6 +114,11 @@ func (r *DownloadClientQbittorrentResource) Schema(_ context.Context, _ resource
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client qBittorrent resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/prowlarr/settings#download-clients) and [qBittorrent](https://wiki.servarr.com/prowlarr/supported#qbittorrent).",
 		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Test the configuration against Prowlarr before applying it.",
+				Optional:            true,
+			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -194,6 +202,8 @@ func (r *DownloadClientQbittorrentResource) Schema(_ context.Context, _ resource
 				Computed:            true,
 				Sensitive:           true,
 			},
+			"password_wo":         writeOnlyAttribute("password", "Password."),
+			"password_wo_version": writeOnlyVersionAttribute("password_wo"),
 			"category": schema.StringAttribute{
 				MarkdownDescription: "Category.",
 				Optional:            true,
@@ -204,10 +214,7 @@ func (r *DownloadClientQbittorrentResource) Schema(_ context.Context, _ resource
 }
 
 func (r *DownloadClientQbittorrentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
-	}
+	r.data = resourceConfigure(ctx, req, resp)
 }
 
 func (r *DownloadClientQbittorrentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -220,10 +227,28 @@ func (r *DownloadClientQbittorrentResource) Create(ctx context.Context, req reso
 		return
 	}
 
+	instance, err := r.data.instance(client.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Create new DownloadClientQbittorrent
 	request := client.read(ctx, &resp.Diagnostics)
+	request.SetFields(readWriteOnlyFields(ctx, req.Config, request.GetFields(), downloadClientWriteOnlyFields, &resp.Diagnostics))
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	if client.TestOnApply.ValueBool() {
+		if _, err = instance.Client.DownloadClientAPI.TestDownloadClient(instance.Auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, downloadClientQbittorrentResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.DownloadClientAPI.CreateDownloadClient(instance.Auth).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientQbittorrentResourceName, err))
 
@@ -246,10 +271,17 @@ func (r *DownloadClientQbittorrentResource) Read(ctx context.Context, req resour
 		return
 	}
 
+	instance, err := r.data.instance(client.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get DownloadClientQbittorrent current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := instance.Client.DownloadClientAPI.GetDownloadClientById(instance.Auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientQbittorrentResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientQbittorrentResourceName, err, httpResp, resp)
 
 		return
 	}
@@ -270,10 +302,28 @@ func (r *DownloadClientQbittorrentResource) Update(ctx context.Context, req reso
 		return
 	}
 
+	instance, err := r.data.instance(client.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Update DownloadClientQbittorrent
 	request := client.read(ctx, &resp.Diagnostics)
+	request.SetFields(readWriteOnlyFields(ctx, req.Config, request.GetFields(), downloadClientWriteOnlyFields, &resp.Diagnostics))
+
+	if client.TestOnApply.ValueBool() {
+		if _, err = instance.Client.DownloadClientAPI.TestDownloadClient(instance.Auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, downloadClientQbittorrentResourceName, err, req.Plan, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.DownloadClientAPI.UpdateDownloadClient(instance.Auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientQbittorrentResourceName, err))
 
@@ -287,16 +337,27 @@ func (r *DownloadClientQbittorrentResource) Update(ctx context.Context, req reso
 }
 
 func (r *DownloadClientQbittorrentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID   int64
+		name types.String
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	instance, err := r.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Delete DownloadClientQbittorrent current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err = instance.Client.DownloadClientAPI.DeleteDownloadClient(instance.Auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientQbittorrentResourceName, err))
 
@@ -308,6 +369,7 @@ func (r *DownloadClientQbittorrentResource) Delete(ctx context.Context, req reso
 }
 
 func (r *DownloadClientQbittorrentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importInstance(ctx, r.data, req.ID, resp)
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
 	tflog.Trace(ctx, "imported "+downloadClientQbittorrentResourceName+": "+req.ID)
 }
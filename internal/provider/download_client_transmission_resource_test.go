@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccDownloadClientTransmissionResource(t *testing.T) {
@@ -64,3 +65,51 @@ func testAccDownloadClientTransmissionResourceConfig(name, enable string) string
 		item_priority = 1
 	}`, enable, name)
 }
+
+func TestAccDownloadClientTransmissionResourceWriteOnly(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDownloadClientTransmissionResourceWriteOnlyConfig("resourceTransmissionWOTest", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("prowlarr_download_client_transmission.test", "password_wo"),
+					resource.TestCheckResourceAttr("prowlarr_download_client_transmission.test", "password_wo_version", "1"),
+					resource.TestCheckResourceAttrSet("prowlarr_download_client_transmission.test", "id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccDownloadClientTransmissionResourceWriteOnlyConfig("resourceTransmissionWOTest", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("prowlarr_download_client_transmission.test", "password_wo"),
+					resource.TestCheckResourceAttr("prowlarr_download_client_transmission.test", "password_wo_version", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDownloadClientTransmissionResourceWriteOnlyConfig(name string, version int) string {
+	return fmt.Sprintf(`
+	resource "prowlarr_download_client_transmission" "test" {
+		enable = false
+		priority = 10
+		name = "%s"
+		host = "transmission"
+		url_base = "/transmission/"
+		port = 9091
+		item_priority = 1
+		username = "test"
+		password_wo = "password%d"
+		password_wo_version = %d
+	}`, name, version, version)
+}
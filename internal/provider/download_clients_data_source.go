@@ -4,11 +4,10 @@ import (
 	"context"
 	"strconv"
 
-	"github.com/devopsarr/prowlarr-go/prowlarr"
 	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
-
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,12 +23,12 @@ func NewDownloadClientsDataSource() datasource.DataSource {
 
 // DownloadClientsDataSource defines the download clients implementation.
 type DownloadClientsDataSource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // DownloadClients describes the download clients data model.
 type DownloadClients struct {
+	Instance        types.String `tfsdk:"instance"`
 	DownloadClients types.Set    `tfsdk:"download_clients"`
 	ID              types.String `tfsdk:"id"`
 }
@@ -43,6 +42,7 @@ func (d *DownloadClientsDataSource) Schema(_ context.Context, _ datasource.Schem
 		// This description is used by the documentation generator and the delay server.
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nList all available [Download Clients](../resources/download_client).",
 		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
 			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -250,15 +250,28 @@ func (d *DownloadClientsDataSource) Schema(_ context.Context, _ datasource.Schem
 }
 
 func (d *DownloadClientsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
-		d.client = client
-		d.auth = auth
-	}
+	d.data = dataSourceConfigure(ctx, req, resp)
 }
 
-func (d *DownloadClientsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *DownloadClientsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the instance from config
+	var name types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := d.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get download clients current value
-	response, _, err := d.client.DownloadClientAPI.ListDownloadClient(d.auth).Execute()
+	response, _, err := instance.Client.DownloadClientAPI.ListDownloadClient(instance.Auth).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientsDataSourceName, err))
 
@@ -274,5 +287,5 @@ func (d *DownloadClientsDataSource) Read(ctx context.Context, _ datasource.ReadR
 
 	clientList, diags := types.SetValueFrom(ctx, DownloadClient{}.getType(), clients)
 	resp.Diagnostics.Append(diags...)
-	resp.Diagnostics.Append(resp.State.Set(ctx, DownloadClients{DownloadClients: clientList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, DownloadClients{Instance: name, DownloadClients: clientList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
 }
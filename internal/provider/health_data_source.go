@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const healthDataSourceName = "health"
+
+// healthSeverities lists the health check types from the least to the most severe.
+var healthSeverities = []string{
+	string(prowlarr.HEALTHCHECKRESULT_OK),
+	string(prowlarr.HEALTHCHECKRESULT_NOTICE),
+	string(prowlarr.HEALTHCHECKRESULT_WARNING),
+	string(prowlarr.HEALTHCHECKRESULT_ERROR),
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &HealthDataSource{}
+
+func NewHealthDataSource() datasource.DataSource {
+	return &HealthDataSource{}
+}
+
+// HealthDataSource defines the health implementation.
+type HealthDataSource struct {
+	data *ProwlarrData
+}
+
+// Health describes the health data model.
+type Health struct {
+	Instance        types.String `tfsdk:"instance"`
+	Checks          types.Set    `tfsdk:"checks"`
+	MinimumSeverity types.String `tfsdk:"minimum_severity"`
+	ID              types.String `tfsdk:"id"`
+}
+
+// HealthCheck is part of Health.
+type HealthCheck struct {
+	Source  types.String `tfsdk:"source"`
+	Type    types.String `tfsdk:"type"`
+	Message types.String `tfsdk:"message"`
+	WikiURL types.String `tfsdk:"wiki_url"`
+}
+
+func (d *HealthDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + healthDataSourceName
+}
+
+func (d *HealthDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:System -->\nList the Health checks currently reported.\nFor more information refer to [Health](https://wiki.servarr.com/prowlarr/system#health) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"minimum_severity": schema.StringAttribute{
+				MarkdownDescription: "Minimum severity of the returned checks. Valid values are `ok`, `notice`, `warning` and `error`. If not set, all checks are returned.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(healthSeverities...),
+				},
+			},
+			"checks": schema.SetNestedAttribute{
+				MarkdownDescription: "Health check list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							MarkdownDescription: "Source of the check.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Severity type. Can be `ok`, `notice`, `warning` or `error`.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "Message.",
+							Computed:            true,
+						},
+						"wiki_url": schema.StringAttribute{
+							MarkdownDescription: "Wiki URL.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *HealthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.data = dataSourceConfigure(ctx, req, resp)
+}
+
+func (d *HealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Health
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := d.data.instance(data.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	// Get health current value
+	response, _, err := instance.Client.HealthAPI.ListHealth(instance.Auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, healthDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+healthDataSourceName)
+	// Map response body to resource schema attribute
+	minimum := max(slices.Index(healthSeverities, data.MinimumSeverity.ValueString()), 0)
+	checks := make([]HealthCheck, 0, len(response))
+
+	for _, h := range response {
+		if slices.Index(healthSeverities, string(h.GetType())) < minimum {
+			continue
+		}
+
+		var check HealthCheck
+
+		check.write(&h)
+		checks = append(checks, check)
+	}
+
+	tfsdk.ValueFrom(ctx, checks, data.Checks.Type(ctx), &data.Checks)
+	// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+	data.ID = types.StringValue(healthDataSourceName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (c *HealthCheck) write(health *prowlarr.HealthResource) {
+	c.Source = types.StringValue(health.GetSource())
+	c.Type = types.StringValue(string(health.GetType()))
+	c.Message = types.StringValue(health.GetMessage())
+	c.WikiURL = types.StringValue(health.GetWikiUrl())
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccHealthDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccHealthDataSourceConfig("error") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Invalid severity
+			{
+				Config:      testAccHealthDataSourceConfig("critical"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			// Read testing
+			{
+				Config: testAccHealthDataSourceConfig("error"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prowlarr_health.test", "id", "health"),
+				),
+			},
+		},
+	})
+}
+
+func testAccHealthDataSourceConfig(severity string) string {
+	return fmt.Sprintf(`
+	data "prowlarr_health" "test" {
+		minimum_severity = "%s"
+	}
+	`, severity)
+}
@@ -0,0 +1,280 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	historyDataSourceName = "history"
+	historyDefaultLimit   = 1000
+	historyPageSize       = 250
+)
+
+// historyEventTypes lists the history event types in the order of their API enum value.
+var historyEventTypes = []string{
+	string(prowlarr.HISTORYEVENTTYPE_UNKNOWN),
+	string(prowlarr.HISTORYEVENTTYPE_RELEASE_GRABBED),
+	string(prowlarr.HISTORYEVENTTYPE_INDEXER_QUERY),
+	string(prowlarr.HISTORYEVENTTYPE_INDEXER_RSS),
+	string(prowlarr.HISTORYEVENTTYPE_INDEXER_AUTH),
+	string(prowlarr.HISTORYEVENTTYPE_INDEXER_INFO),
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &HistoryDataSource{}
+
+func NewHistoryDataSource() datasource.DataSource {
+	return &HistoryDataSource{}
+}
+
+// HistoryDataSource defines the history implementation.
+type HistoryDataSource struct {
+	data *ProwlarrData
+}
+
+// History describes the history data model.
+type History struct {
+	Instance   types.String `tfsdk:"instance"`
+	Records    types.List   `tfsdk:"records"`
+	EventTypes types.Set    `tfsdk:"event_types"`
+	IndexerIDs types.Set    `tfsdk:"indexer_ids"`
+	StartDate  types.String `tfsdk:"start_date"`
+	EndDate    types.String `tfsdk:"end_date"`
+	ID         types.String `tfsdk:"id"`
+	Limit      types.Int64  `tfsdk:"limit"`
+	Successful types.Bool   `tfsdk:"successful"`
+}
+
+// HistoryRecord is part of History.
+type HistoryRecord struct {
+	Data       types.Map    `tfsdk:"data"`
+	Date       types.String `tfsdk:"date"`
+	DownloadID types.String `tfsdk:"download_id"`
+	EventType  types.String `tfsdk:"event_type"`
+	ID         types.Int64  `tfsdk:"id"`
+	IndexerID  types.Int64  `tfsdk:"indexer_id"`
+	Successful types.Bool   `tfsdk:"successful"`
+}
+
+func (d *HistoryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + historyDataSourceName
+}
+
+func (d *HistoryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:System -->\nList the History records, from the most recent.\nFor more information refer to [History](https://wiki.servarr.com/prowlarr/history) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"event_types": schema.SetAttribute{
+				MarkdownDescription: "Filter by event types. Valid values are `unknown`, `releaseGrabbed`, `indexerQuery`, `indexerRss`, `indexerAuth` and `indexerInfo`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(historyEventTypes...)),
+				},
+			},
+			"indexer_ids": schema.SetAttribute{
+				MarkdownDescription: "Filter by indexer IDs.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"successful": schema.BoolAttribute{
+				MarkdownDescription: "Filter by success flag.",
+				Optional:            true,
+			},
+			"start_date": schema.StringAttribute{
+				MarkdownDescription: "Start of the date range (RFC3339).",
+				Optional:            true,
+			},
+			"end_date": schema.StringAttribute{
+				MarkdownDescription: "End of the date range (RFC3339).",
+				Optional:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of returned records. Defaults to `1000`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"records": schema.ListNestedAttribute{
+				MarkdownDescription: "History record list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Record ID.",
+							Computed:            true,
+						},
+						"indexer_id": schema.Int64Attribute{
+							MarkdownDescription: "Indexer ID.",
+							Computed:            true,
+						},
+						"date": schema.StringAttribute{
+							MarkdownDescription: "Event date (RFC3339).",
+							Computed:            true,
+						},
+						"download_id": schema.StringAttribute{
+							MarkdownDescription: "Download ID.",
+							Computed:            true,
+						},
+						"successful": schema.BoolAttribute{
+							MarkdownDescription: "Success flag.",
+							Computed:            true,
+						},
+						"event_type": schema.StringAttribute{
+							MarkdownDescription: "Event type.",
+							Computed:            true,
+						},
+						"data": schema.MapAttribute{
+							MarkdownDescription: "Event data, e.g. `query`, `source`, `elapsedTime`.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *HistoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.data = dataSourceConfigure(ctx, req, resp)
+}
+
+func (d *HistoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *History
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := d.data.instance(data.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	startDate, hasStart := helpers.ParseTimeAttribute(data.StartDate, path.Root("start_date"), &resp.Diagnostics)
+	endDate, hasEnd := helpers.ParseTimeAttribute(data.EndDate, path.Root("end_date"), &resp.Diagnostics)
+
+	limit := int(data.Limit.ValueInt64())
+	if data.Limit.IsNull() {
+		limit = historyDefaultLimit
+	}
+
+	eventTypes := make([]string, len(data.EventTypes.Elements()))
+	resp.Diagnostics.Append(data.EventTypes.ElementsAs(ctx, &eventTypes, true)...)
+
+	indexerIDs := make([]int32, len(data.IndexerIDs.Elements()))
+	resp.Diagnostics.Append(data.IndexerIDs.ElementsAs(ctx, &indexerIDs, true)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build filters
+	request := instance.Client.HistoryAPI.GetHistory(instance.Auth).
+		PageSize(historyPageSize).
+		SortKey("date").
+		SortDirection(prowlarr.SORTDIRECTION_DESCENDING).
+		EventType(historyEventTypeIDs(eventTypes)).
+		IndexerIds(indexerIDs)
+
+	if !data.Successful.IsNull() {
+		request = request.Successful(data.Successful.ValueBool())
+	}
+
+	// Page through history until the limit, the start date or the last record are reached
+	records := make([]HistoryRecord, 0)
+
+	for page := int32(1); ; page++ {
+		response, _, err := request.Page(page).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, historyDataSourceName, err))
+
+			return
+		}
+
+		older := false
+
+		for _, h := range response.GetRecords() {
+			date := h.GetDate()
+			if hasStart && date.Before(startDate) {
+				older = true
+
+				break
+			}
+
+			if hasEnd && date.After(endDate) {
+				continue
+			}
+
+			var record HistoryRecord
+
+			record.write(ctx, &h)
+
+			if records = append(records, record); len(records) == limit {
+				break
+			}
+		}
+
+		if older || len(records) == limit || int(page)*historyPageSize >= int(response.GetTotalRecords()) {
+			break
+		}
+	}
+
+	tflog.Trace(ctx, "read "+historyDataSourceName)
+	// Map response body to resource schema attribute
+	tfsdk.ValueFrom(ctx, records, data.Records.Type(ctx), &data.Records)
+	// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+	data.ID = types.StringValue(strconv.Itoa(len(records)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// historyEventTypeIDs converts event type names into their API enum values.
+func historyEventTypeIDs(eventTypes []string) []int32 {
+	ids := make([]int32, 0, len(eventTypes))
+	for _, e := range eventTypes {
+		if i := slices.Index(historyEventTypes, e); i >= 0 {
+			ids = append(ids, int32(i))
+		}
+	}
+
+	return ids
+}
+
+func (r *HistoryRecord) write(ctx context.Context, history *prowlarr.HistoryResource) {
+	date, _ := history.GetDateOk()
+
+	r.ID = types.Int64Value(int64(history.GetId()))
+	r.IndexerID = types.Int64Value(int64(history.GetIndexerId()))
+	r.Date = helpers.TimeValue(date)
+	r.DownloadID = types.StringValue(history.GetDownloadId())
+	r.Successful = types.BoolValue(history.GetSuccessful())
+	r.EventType = types.StringValue(string(history.GetEventType()))
+	r.Data, _ = types.MapValueFrom(ctx, types.StringType, history.GetData())
+}
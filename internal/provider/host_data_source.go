@@ -3,10 +3,10 @@ package provider
 import (
 	"context"
 
-	"github.com/devopsarr/prowlarr-go/prowlarr"
 	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -22,8 +22,7 @@ func NewHostDataSource() datasource.DataSource {
 
 // HostDataSource defines the host implementation.
 type HostDataSource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 func (d *HostDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -35,6 +34,7 @@ func (d *HostDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 		// This description is used by the documentation generator and the delay server.
 		MarkdownDescription: "<!-- subcategory:System -->\n[Host](../resources/host).",
 		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
 			"launch_browser": schema.BoolAttribute{
 				MarkdownDescription: "Launch browser flag.",
 				Computed:            true,
@@ -224,18 +224,31 @@ func (d *HostDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 }
 
 func (d *HostDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
-		d.client = client
-		d.auth = auth
-	}
+	d.data = dataSourceConfigure(ctx, req, resp)
 }
 
-func (d *HostDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *HostDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the instance from config
+	var name types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := d.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// assign default empty password value to empty string since it cannot be read
 	auth := AuthConfig{
 		Password: types.StringValue(""),
 	}
-	state := Host{}
+	state := Host{Instance: name}
 
 	configs := []hostConfigEntry{
 		{auth, auth.getType(), &state.AuthConfig, "auth"},
@@ -250,7 +263,7 @@ func (d *HostDataSource) Read(ctx context.Context, _ datasource.ReadRequest, res
 	}
 
 	// Get host current value
-	response, _, err := d.client.HostConfigAPI.GetHostConfig(d.auth).Execute()
+	response, _, err := instance.Client.HostConfigAPI.GetHostConfig(instance.Auth).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, hostDataSourceName, err))
 
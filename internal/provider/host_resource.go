@@ -38,12 +38,12 @@ func NewHostResource() resource.Resource {
 
 // HostResource defines the host implementation.
 type HostResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // Host describes the host data model.
 type Host struct {
+	Instance       types.String `tfsdk:"instance"`
 	ProxyConfig    types.Object `tfsdk:"proxy"`
 	SSLConfig      types.Object `tfsdk:"ssl"`
 	AuthConfig     types.Object `tfsdk:"authentication"`
@@ -185,6 +185,7 @@ func (r *HostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:System -->\nHost resource.\nFor more information refer to [Host](https://wiki.servarr.com/prowlarr/settings#general) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
 			"launch_browser": schema.BoolAttribute{
 				MarkdownDescription: "Launch browser flag.",
 				Optional:            true,
@@ -404,10 +405,7 @@ func (r *HostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 }
 
 func (r *HostResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
-	}
+	r.data = resourceConfigure(ctx, req, resp)
 }
 
 func (r *HostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -420,12 +418,19 @@ func (r *HostResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	instance, err := r.data.instance(host.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Build Create resource
 	request := host.read(ctx, &resp.Diagnostics)
 	request.SetId(1)
 
 	// Create new Host
-	response, _, err := r.client.HostConfigAPI.UpdateHostConfig(r.auth, strconv.Itoa(int(request.GetId()))).HostConfigResource(*request).Execute()
+	response, _, err := instance.Client.HostConfigAPI.UpdateHostConfig(instance.Auth, strconv.Itoa(int(request.GetId()))).HostConfigResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, hostResourceName, err))
 
@@ -448,8 +453,15 @@ func (r *HostResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	instance, err := r.data.instance(host.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get host current value
-	response, _, err := r.client.HostConfigAPI.GetHostConfig(r.auth).Execute()
+	response, _, err := instance.Client.HostConfigAPI.GetHostConfig(instance.Auth).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, hostResourceName, err))
 
@@ -472,11 +484,18 @@ func (r *HostResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	instance, err := r.data.instance(host.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Build Update resource
 	request := host.read(ctx, &resp.Diagnostics)
 
 	// Update Host
-	response, _, err := r.client.HostConfigAPI.UpdateHostConfig(r.auth, strconv.Itoa(int(request.GetId()))).HostConfigResource(*request).Execute()
+	response, _, err := instance.Client.HostConfigAPI.UpdateHostConfig(instance.Auth, strconv.Itoa(int(request.GetId()))).HostConfigResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, hostResourceName, err))
 
@@ -496,6 +515,7 @@ func (r *HostResource) Delete(ctx context.Context, _ resource.DeleteRequest, res
 }
 
 func (r *HostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importInstance(ctx, r.data, req.ID, resp)
 	tflog.Trace(ctx, "imported "+hostResourceName+": 1")
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), 1)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("authentication").AtName("password"), req.ID)...)
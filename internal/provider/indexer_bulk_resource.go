@@ -0,0 +1,425 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const indexerBulkResourceName = "indexer_bulk"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IndexerBulkResource{}
+
+func NewIndexerBulkResource() resource.Resource {
+	return &IndexerBulkResource{}
+}
+
+// IndexerBulkResource defines the indexer bulk implementation.
+type IndexerBulkResource struct {
+	data *ProwlarrData
+}
+
+// IndexerBulk describes the indexer bulk data model.
+type IndexerBulk struct {
+	Instance        types.String  `tfsdk:"instance"`
+	SeedRatio       types.Float64 `tfsdk:"seed_ratio"`
+	IDs             types.Set     `tfsdk:"ids"`
+	Tags            types.Set     `tfsdk:"tags"`
+	ApplyTags       types.String  `tfsdk:"apply_tags"`
+	ID              types.String  `tfsdk:"id"`
+	AppProfileID    types.Int64   `tfsdk:"app_profile_id"`
+	Priority        types.Int64   `tfsdk:"priority"`
+	MinimumSeeders  types.Int64   `tfsdk:"minimum_seeders"`
+	SeedTime        types.Int64   `tfsdk:"seed_time"`
+	PackSeedTime    types.Int64   `tfsdk:"pack_seed_time"`
+	Enable          types.Bool    `tfsdk:"enable"`
+	PreferMagnetURL types.Bool    `tfsdk:"prefer_magnet_url"`
+}
+
+func (r *IndexerBulkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + indexerBulkResourceName
+}
+
+func (r *IndexerBulkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer Bulk resource.\nApply the same partial update to a set of [Indexers](../resources/indexer) in a single call. Only the configured attributes are managed and checked for drift.\nDestroying the resource leaves the indexers as they are.\nFor more information refer to [Indexers](https://wiki.servarr.com/prowlarr/indexers) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Indexer Bulk ID, the sorted list of indexer IDs.",
+				Computed:            true,
+			},
+			"ids": schema.SetAttribute{
+				MarkdownDescription: "Indexer IDs.",
+				Required:            true,
+				ElementType:         types.Int64Type,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"enable": schema.BoolAttribute{
+				MarkdownDescription: "Enable flag.",
+				Optional:            true,
+			},
+			"app_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Application profile ID.",
+				Optional:            true,
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "Priority.",
+				Optional:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List of associated tags.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"apply_tags": schema.StringAttribute{
+				MarkdownDescription: "How tags are applied. Valid values are `add`, `remove` and `replace`. Defaults to `add`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(helpers.ApplyTagsAdd),
+				Validators: []validator.String{
+					stringvalidator.OneOf(helpers.ApplyTagsAdd, helpers.ApplyTagsRemove, helpers.ApplyTagsReplace),
+				},
+			},
+			"minimum_seeders": schema.Int64Attribute{
+				MarkdownDescription: "Apps minimum seeders. Torrent indexers only.",
+				Optional:            true,
+			},
+			"seed_ratio": schema.Float64Attribute{
+				MarkdownDescription: "Seed ratio. Torrent indexers only.",
+				Optional:            true,
+			},
+			"seed_time": schema.Int64Attribute{
+				MarkdownDescription: "Seed time. Torrent indexers only.",
+				Optional:            true,
+			},
+			"pack_seed_time": schema.Int64Attribute{
+				MarkdownDescription: "Pack seed time. Torrent indexers only.",
+				Optional:            true,
+			},
+			"prefer_magnet_url": schema.BoolAttribute{
+				MarkdownDescription: "Prefer magnet URL flag. Torrent indexers only.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *IndexerBulkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.data = resourceConfigure(ctx, req, resp)
+}
+
+func (r *IndexerBulkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var bulk *IndexerBulk
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &bulk)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.data.instance(bulk.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	r.apply(ctx, instance, bulk, helpers.Create, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created "+indexerBulkResourceName+": "+bulk.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &bulk)...)
+}
+
+func (r *IndexerBulkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var bulk *IndexerBulk
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &bulk)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.data.instance(bulk.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	// Get indexers current value
+	response, _, err := instance.Client.IndexerAPI.ListIndexer(instance.Auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerBulkResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+indexerBulkResourceName+": "+bulk.ID.ValueString())
+	// Map response body to resource schema attribute
+	found := bulk.write(ctx, response, &resp.Diagnostics)
+	// Objects deleted outside of Terraform are reported as drift on ids
+	bulk.IDs, bulk.ID = writeBulkIDs(ctx, found, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &bulk)...)
+}
+
+func (r *IndexerBulkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var bulk *IndexerBulk
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &bulk)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.data.instance(bulk.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	r.apply(ctx, instance, bulk, helpers.Update, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+indexerBulkResourceName+": "+bulk.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &bulk)...)
+}
+
+func (r *IndexerBulkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var bulk *IndexerBulk
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &bulk)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bulk edits cannot be reverted just removing them from state
+	tflog.Trace(ctx, "decoupled "+indexerBulkResourceName+": "+bulk.ID.ValueString())
+	resp.State.RemoveResource(ctx)
+}
+
+// apply sends the bulk edit and refreshes the given model.
+func (r *IndexerBulkResource) apply(ctx context.Context, instance *ProwlarrData, bulk *IndexerBulk, action string, diags *diag.Diagnostics) {
+	request := bulk.read(ctx, diags)
+	if diags.HasError() {
+		return
+	}
+
+	if _, _, err := instance.Client.IndexerAPI.PutIndexerBulk(instance.Auth).IndexerBulkResource(*request).Execute(); err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, indexerBulkResourceName, err))
+
+		return
+	}
+
+	response, _, err := instance.Client.IndexerAPI.ListIndexer(instance.Auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, indexerBulkResourceName, err))
+
+		return
+	}
+
+	found := bulk.write(ctx, response, diags)
+	// The configured ids are kept in state, so missing objects fail the apply
+	checkBulkIDs(ctx, indexerResourceName, bulk.IDs, found, diags)
+	_, bulk.ID = writeBulkIDs(ctx, found, diags)
+}
+
+// write refreshes the managed attributes and returns the IDs of the existing objects.
+func (b *IndexerBulk) write(ctx context.Context, indexers []prowlarr.IndexerResource, diags *diag.Diagnostics) []int32 {
+	ids := make([]int32, 0, len(b.IDs.Elements()))
+	diags.Append(b.IDs.ElementsAs(ctx, &ids, false)...)
+
+	// Only the indexers in the bulk still existing are considered
+	indexers = slices.DeleteFunc(slices.Clone(indexers), func(i prowlarr.IndexerResource) bool {
+		return !slices.Contains(ids, i.GetId())
+	})
+
+	found := make([]int32, len(indexers))
+	enable := make([]bool, len(indexers))
+	appProfileIDs := make([]int64, len(indexers))
+	priorities := make([]int64, len(indexers))
+	tags := make([][]int32, len(indexers))
+
+	for n, i := range indexers {
+		found[n] = i.GetId()
+		enable[n] = i.GetEnable()
+		appProfileIDs[n] = int64(i.GetAppProfileId())
+		priorities[n] = int64(i.GetPriority())
+		tags[n] = i.GetTags()
+	}
+
+	if !b.Enable.IsNull() {
+		b.Enable = types.BoolValue(helpers.BulkValue(b.Enable.ValueBool(), enable))
+	}
+
+	if !b.AppProfileID.IsNull() {
+		b.AppProfileID = types.Int64Value(helpers.BulkValue(b.AppProfileID.ValueInt64(), appProfileIDs))
+	}
+
+	if !b.Priority.IsNull() {
+		b.Priority = types.Int64Value(helpers.BulkValue(b.Priority.ValueInt64(), priorities))
+	}
+
+	b.Tags = writeBulkTags(ctx, b.Tags, b.ApplyTags.ValueString(), tags, diags)
+
+	// Torrent settings are stored as fields, missing on usenet indexers
+	if !b.MinimumSeeders.IsNull() {
+		b.MinimumSeeders = types.Int64Value(int64(helpers.BulkValue(float64(b.MinimumSeeders.ValueInt64()), indexerFieldValues[float64](indexers, "torrentBaseSettings.appMinimumSeeders"))))
+	}
+
+	if !b.SeedRatio.IsNull() {
+		b.SeedRatio = types.Float64Value(helpers.BulkValue(b.SeedRatio.ValueFloat64(), indexerFieldValues[float64](indexers, "torrentBaseSettings.seedRatio")))
+	}
+
+	if !b.SeedTime.IsNull() {
+		b.SeedTime = types.Int64Value(int64(helpers.BulkValue(float64(b.SeedTime.ValueInt64()), indexerFieldValues[float64](indexers, "torrentBaseSettings.seedTime"))))
+	}
+
+	if !b.PackSeedTime.IsNull() {
+		b.PackSeedTime = types.Int64Value(int64(helpers.BulkValue(float64(b.PackSeedTime.ValueInt64()), indexerFieldValues[float64](indexers, "torrentBaseSettings.packSeedTime"))))
+	}
+
+	if !b.PreferMagnetURL.IsNull() {
+		b.PreferMagnetURL = types.BoolValue(helpers.BulkValue(b.PreferMagnetURL.ValueBool(), indexerFieldValues[bool](indexers, "torrentBaseSettings.preferMagnetUrl")))
+	}
+
+	return found
+}
+
+func (b *IndexerBulk) read(ctx context.Context, diags *diag.Diagnostics) *prowlarr.IndexerBulkResource {
+	bulk := prowlarr.NewIndexerBulkResource()
+
+	ids := make([]int32, 0, len(b.IDs.Elements()))
+	diags.Append(b.IDs.ElementsAs(ctx, &ids, false)...)
+	bulk.SetIds(ids)
+
+	if !b.Tags.IsNull() {
+		tags := make([]int32, 0, len(b.Tags.Elements()))
+		diags.Append(b.Tags.ElementsAs(ctx, &tags, false)...)
+		bulk.SetTags(tags)
+		bulk.SetApplyTags(prowlarr.ApplyTags(b.ApplyTags.ValueString()))
+	}
+
+	if !b.Enable.IsNull() {
+		bulk.SetEnable(b.Enable.ValueBool())
+	}
+
+	if !b.AppProfileID.IsNull() {
+		bulk.SetAppProfileId(int32(b.AppProfileID.ValueInt64()))
+	}
+
+	if !b.Priority.IsNull() {
+		bulk.SetPriority(int32(b.Priority.ValueInt64()))
+	}
+
+	if !b.MinimumSeeders.IsNull() {
+		bulk.SetMinimumSeeders(int32(b.MinimumSeeders.ValueInt64()))
+	}
+
+	if !b.SeedRatio.IsNull() {
+		bulk.SetSeedRatio(b.SeedRatio.ValueFloat64())
+	}
+
+	if !b.SeedTime.IsNull() {
+		bulk.SetSeedTime(int32(b.SeedTime.ValueInt64()))
+	}
+
+	if !b.PackSeedTime.IsNull() {
+		bulk.SetPackSeedTime(int32(b.PackSeedTime.ValueInt64()))
+	}
+
+	if !b.PreferMagnetURL.IsNull() {
+		bulk.SetPreferMagnetUrl(b.PreferMagnetURL.ValueBool())
+	}
+
+	return bulk
+}
+
+// indexerFieldValues collects the values of the given field on the indexers exposing it.
+func indexerFieldValues[T any](indexers []prowlarr.IndexerResource, name string) []T {
+	values := make([]T, 0, len(indexers))
+
+	for _, i := range indexers {
+		for _, f := range i.GetFields() {
+			if value, ok := f.GetValue().(T); ok && f.GetName() == name {
+				values = append(values, value)
+			}
+		}
+	}
+
+	return values
+}
+
+// writeBulkTags returns the managed tags effectively applied to the objects of a bulk resource.
+func writeBulkTags(ctx context.Context, tags types.Set, mode string, actuals [][]int32, diags *diag.Diagnostics) types.Set {
+	if tags.IsNull() {
+		return tags
+	}
+
+	desired := make([]int32, 0, len(tags.Elements()))
+	diags.Append(tags.ElementsAs(ctx, &desired, false)...)
+
+	applied, localDiag := types.SetValueFrom(ctx, types.Int64Type, helpers.BulkTags(desired, actuals, mode))
+	diags.Append(localDiag...)
+
+	return applied
+}
+
+// writeBulkIDs returns the IDs of the objects still existing in a bulk resource and the resource ID built from them.
+func writeBulkIDs(ctx context.Context, found []int32, diags *diag.Diagnostics) (types.Set, types.String) {
+	slices.Sort(found)
+
+	values := make([]string, len(found))
+	for i, id := range found {
+		values[i] = strconv.Itoa(int(id))
+	}
+
+	ids, localDiag := types.SetValueFrom(ctx, types.Int64Type, found)
+	diags.Append(localDiag...)
+
+	return ids, types.StringValue(strings.Join(values, ","))
+}
+
+// checkBulkIDs adds an error on ids for the configured objects which do not exist.
+func checkBulkIDs(ctx context.Context, kind string, ids types.Set, found []int32, diags *diag.Diagnostics) {
+	configured := make([]int32, 0, len(ids.Elements()))
+	diags.Append(ids.ElementsAs(ctx, &configured, false)...)
+
+	missing := slices.DeleteFunc(configured, func(id int32) bool { return slices.Contains(found, id) })
+	if len(missing) == 0 {
+		return
+	}
+
+	slices.Sort(missing)
+	diags.AddAttributeError(path.Root("ids"), helpers.ResourceError, fmt.Sprintf("Unable to find %s with IDs %v.", kind, missing))
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccIndexerBulkResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccIndexerBulkResourceConfig(10) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccIndexerBulkResourceConfig(10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_indexer_bulk.test", "priority", "10"),
+					resource.TestCheckResourceAttr("prowlarr_indexer_bulk.test", "seed_ratio", "2"),
+					resource.TestCheckResourceAttrSet("prowlarr_indexer_bulk.test", "id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccIndexerBulkResourceConfig(10) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccIndexerBulkResourceConfig(20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_indexer_bulk.test", "priority", "20"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccIndexerBulkResourceConfig(priority int) string {
+	return fmt.Sprintf(`
+	resource "prowlarr_tag" "bulk" {
+		label = "indexerbulk"
+	}
+
+	resource "prowlarr_indexer" "bulk" {
+		enable = false
+		name = "BulkTest"
+		implementation = "Cardigann"
+		config_contract = "CardigannSettings"
+		protocol = "torrent"
+		app_profile_id = 1
+		priority = 1
+		tags = []
+
+		fields = [
+			{
+				name = "definitionFile"
+				text_value = "0magnet"
+			},
+			{
+				name = "baseUrl"
+				text_value = "https://0magnet.co/"
+			},
+		]
+
+		lifecycle {
+			ignore_changes = [priority, tags, fields]
+		}
+	}
+
+	resource "prowlarr_indexer_bulk" "test" {
+		ids = [prowlarr_indexer.bulk.id]
+		priority = %d
+		tags = [prowlarr_tag.bulk.id]
+		apply_tags = "add"
+		seed_ratio = 2
+	}`, priority)
+}
+
+func TestCheckBulkIDs(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		detail string
+		found  []int32
+	}{
+		"all found": {
+			found: []int32{1, 2, 3},
+		},
+		"extra found": {
+			found: []int32{1, 2, 3, 4},
+		},
+		"missing": {
+			found:  []int32{2},
+			detail: "Unable to find indexer with IDs [1 3].",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			ids := types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(3), types.Int64Value(1), types.Int64Value(2)})
+			checkBulkIDs(context.Background(), indexerResourceName, ids, test.found, &diags)
+
+			if test.detail == "" {
+				assert.False(t, diags.HasError())
+
+				return
+			}
+
+			assert.Equal(t, 1, diags.ErrorsCount())
+			assert.Equal(t, test.detail, diags[0].Detail())
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const indexerCategoriesDataSourceName = "indexer_categories"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IndexerCategoriesDataSource{}
+
+func NewIndexerCategoriesDataSource() datasource.DataSource {
+	return &IndexerCategoriesDataSource{}
+}
+
+// IndexerCategoriesDataSource defines the indexer categories implementation.
+type IndexerCategoriesDataSource struct {
+	data *ProwlarrData
+}
+
+// IndexerCategories describes the indexer categories data model.
+type IndexerCategories struct {
+	Instance   types.String `tfsdk:"instance"`
+	Categories types.Set    `tfsdk:"categories"`
+	ID         types.String `tfsdk:"id"`
+}
+
+// IndexerCategory describes the indexer category data model.
+type IndexerCategory struct {
+	SubCategories types.Set    `tfsdk:"sub_categories"`
+	Name          types.String `tfsdk:"name"`
+	ID            types.Int64  `tfsdk:"id"`
+}
+
+// IndexerSubCategory describes the indexer sub category data model.
+type IndexerSubCategory struct {
+	Name types.String `tfsdk:"name"`
+	ID   types.Int64  `tfsdk:"id"`
+}
+
+func (c IndexerCategory) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"sub_categories": types.SetType{}.WithElementType(IndexerSubCategory{}.getType()),
+			"name":           types.StringType,
+			"id":             types.Int64Type,
+		})
+}
+
+func (c IndexerSubCategory) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"name": types.StringType,
+			"id":   types.Int64Type,
+		})
+}
+
+func (d *IndexerCategoriesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + indexerCategoriesDataSourceName
+}
+
+func (d *IndexerCategoriesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Indexers -->\nList all the Newznab categories known by Prowlarr, with their sub categories.",
+		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"categories": schema.SetNestedAttribute{
+				MarkdownDescription: "Category list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Category ID.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Category name.",
+							Computed:            true,
+						},
+						"sub_categories": schema.SetNestedAttribute{
+							MarkdownDescription: "Sub category list.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.Int64Attribute{
+										MarkdownDescription: "Sub category ID.",
+										Computed:            true,
+									},
+									"name": schema.StringAttribute{
+										MarkdownDescription: "Sub category name.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IndexerCategoriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.data = dataSourceConfigure(ctx, req, resp)
+}
+
+func (d *IndexerCategoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the instance from config
+	var name types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := d.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	// Get indexer categories current value
+	response, _, err := instance.Client.IndexerDefaultCategoriesAPI.ListIndexerCategories(instance.Auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerCategoriesDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+indexerCategoriesDataSourceName)
+	// Map response body to resource schema attribute
+	categories := make([]IndexerCategory, len(response))
+	for i, c := range response {
+		categories[i].write(ctx, &c, &resp.Diagnostics)
+	}
+
+	categoryList, diags := types.SetValueFrom(ctx, IndexerCategory{}.getType(), categories)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, IndexerCategories{Instance: name, Categories: categoryList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+}
+
+func (c *IndexerCategory) write(ctx context.Context, category *prowlarr.IndexerCategory, diags *diag.Diagnostics) {
+	var localDiag diag.Diagnostics
+
+	c.ID = types.Int64Value(int64(category.GetId()))
+	c.Name = types.StringValue(category.GetName())
+
+	subCategories := make([]IndexerSubCategory, len(category.SubCategories))
+	for i, s := range category.SubCategories {
+		subCategories[i].write(&s)
+	}
+
+	c.SubCategories, localDiag = types.SetValueFrom(ctx, IndexerSubCategory{}.getType(), subCategories)
+	diags.Append(localDiag...)
+}
+
+func (c *IndexerSubCategory) write(category *prowlarr.IndexerCategory) {
+	c.ID = types.Int64Value(int64(category.GetId()))
+	c.Name = types.StringValue(category.GetName())
+}
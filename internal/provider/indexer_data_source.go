@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -23,8 +24,13 @@ func NewIndexerDataSource() datasource.DataSource {
 
 // IndexerDataSource defines the indexer implementation.
 type IndexerDataSource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
+}
+
+// InstanceIndexer extends Indexer with the instance attribute, which the list elements do not have.
+type InstanceIndexer struct {
+	Instance types.String `tfsdk:"instance"`
+	Indexer
 }
 
 func (d *IndexerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -36,6 +42,7 @@ func (d *IndexerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nSingle [Indexer](../resources/indexer).",
 		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable RSS flag.",
 				Computed:            true,
@@ -124,14 +131,11 @@ func (d *IndexerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 }
 
 func (d *IndexerDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
-		d.client = client
-		d.auth = auth
-	}
+	d.data = dataSourceConfigure(ctx, req, resp)
 }
 
 func (d *IndexerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data *Indexer
+	var data *InstanceIndexer
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
@@ -139,8 +143,15 @@ func (d *IndexerDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	instance, err := d.data.instance(data.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get indexers current value
-	response, _, err := d.client.IndexerAPI.ListIndexer(d.auth).Execute()
+	response, _, err := instance.Client.IndexerAPI.ListIndexer(instance.Auth).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerDataSourceName, err))
 
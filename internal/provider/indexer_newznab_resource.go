@@ -0,0 +1,385 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	indexerNewznabResourceName   = "indexer_newznab"
+	indexerNewznabImplementation = "Newznab"
+	indexerNewznabConfigContract = "NewznabSettings"
+	indexerNewznabProtocol       = "usenet"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &IndexerNewznabResource{}
+	_ resource.ResourceWithImportState = &IndexerNewznabResource{}
+)
+
+var indexerNewznabFields = helpers.Fields{
+	Strings:        []string{"baseUrl", "apiPath", "apiKey", "additionalParameters", "vipExpiration"},
+	Ints:           []string{"queryLimit", "grabLimit", "limitsUnit"},
+	IntsExceptions: []string{"baseSettings.queryLimit", "baseSettings.grabLimit", "baseSettings.limitsUnit"},
+}
+
+func NewIndexerNewznabResource() resource.Resource {
+	return &IndexerNewznabResource{}
+}
+
+// IndexerNewznabResource defines the Newznab indexer implementation.
+type IndexerNewznabResource struct {
+	data *ProwlarrData
+}
+
+// IndexerNewznab describes the Newznab indexer data model.
+type IndexerNewznab struct {
+	Instance             types.String `tfsdk:"instance"`
+	Tags                 types.Set    `tfsdk:"tags"`
+	Categories           types.Set    `tfsdk:"categories"`
+	Name                 types.String `tfsdk:"name"`
+	Language             types.String `tfsdk:"language"`
+	Privacy              types.String `tfsdk:"privacy"`
+	BaseURL              types.String `tfsdk:"base_url"`
+	APIPath              types.String `tfsdk:"api_path"`
+	APIKey               types.String `tfsdk:"api_key"`
+	AdditionalParameters types.String `tfsdk:"additional_parameters"`
+	VipExpiration        types.String `tfsdk:"vip_expiration"`
+	AppProfileID         types.Int64  `tfsdk:"app_profile_id"`
+	Priority             types.Int64  `tfsdk:"priority"`
+	QueryLimit           types.Int64  `tfsdk:"query_limit"`
+	GrabLimit            types.Int64  `tfsdk:"grab_limit"`
+	LimitsUnit           types.Int64  `tfsdk:"limits_unit"`
+	ID                   types.Int64  `tfsdk:"id"`
+	Enable               types.Bool   `tfsdk:"enable"`
+	Redirect             types.Bool   `tfsdk:"redirect"`
+	TestOnApply          types.Bool   `tfsdk:"test_on_apply"`
+}
+
+func (i IndexerNewznab) toIndexer() *Indexer {
+	return &Indexer{
+		Tags:           i.Tags,
+		Name:           i.Name,
+		Language:       i.Language,
+		Privacy:        i.Privacy,
+		AppProfileID:   i.AppProfileID,
+		Priority:       i.Priority,
+		ID:             i.ID,
+		Enable:         i.Enable,
+		Redirect:       i.Redirect,
+		Fields:         types.SetNull(IndexerResource{}.getFieldSchema().Type()),
+		ConfigContract: types.StringValue(indexerNewznabConfigContract),
+		Implementation: types.StringValue(indexerNewznabImplementation),
+		Protocol:       types.StringValue(indexerNewznabProtocol),
+	}
+}
+
+func (i *IndexerNewznab) fromIndexer(indexer *Indexer) {
+	i.Tags = indexer.Tags
+	i.Name = indexer.Name
+	i.Language = indexer.Language
+	i.Privacy = indexer.Privacy
+	i.AppProfileID = indexer.AppProfileID
+	i.Priority = indexer.Priority
+	i.ID = indexer.ID
+	i.Enable = indexer.Enable
+	i.Redirect = indexer.Redirect
+}
+
+func (r *IndexerNewznabResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + indexerNewznabResourceName
+}
+
+func (r *IndexerNewznabResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer Newznab resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/prowlarr/indexers) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Test the configuration against Prowlarr before applying it.",
+				Optional:            true,
+			},
+			"enable": schema.BoolAttribute{
+				MarkdownDescription: "Enable flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"redirect": schema.BoolAttribute{
+				MarkdownDescription: "Redirect download request from client to indexer instead of proxying via Prowlarr.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "Priority.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"app_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Application profile ID.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Indexer name.",
+				Required:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List of associated tags.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"language": schema.StringAttribute{
+				MarkdownDescription: "Language.",
+				Computed:            true,
+			},
+			"privacy": schema.StringAttribute{
+				MarkdownDescription: "Privacy.",
+				Computed:            true,
+			},
+			"categories": schema.SetAttribute{
+				MarkdownDescription: "Categories supported by the indexer, as reported by its capabilities.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Indexer ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			// Field values
+			"base_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL.",
+				Required:            true,
+			},
+			"api_path": schema.StringAttribute{
+				MarkdownDescription: "API path.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: "API key.",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"additional_parameters": schema.StringAttribute{
+				MarkdownDescription: "Additional parameters.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"vip_expiration": schema.StringAttribute{
+				MarkdownDescription: "VIP expiration date.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"query_limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of queries per limits unit.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"grab_limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of grabs per limits unit.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"limits_unit": schema.Int64Attribute{
+				MarkdownDescription: "Limits unit. `0` Day, `1` Hour.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(0, 1),
+				},
+			},
+		},
+	}
+}
+
+func (r *IndexerNewznabResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.data = resourceConfigure(ctx, req, resp)
+}
+
+func (r *IndexerNewznabResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var indexer *IndexerNewznab
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.data.instance(indexer.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	// Create new IndexerNewznab
+	request := indexer.read(ctx, &resp.Diagnostics)
+
+	if indexer.TestOnApply.ValueBool() {
+		if _, err = instance.Client.IndexerAPI.TestIndexer(instance.Auth).IndexerResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, indexerNewznabResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.IndexerAPI.CreateIndexer(instance.Auth).IndexerResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerNewznabResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+indexerNewznabResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	indexer.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+}
+
+func (r *IndexerNewznabResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var indexer *IndexerNewznab
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &indexer)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.data.instance(indexer.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	// Get IndexerNewznab current value
+	response, httpResp, err := instance.Client.IndexerAPI.GetIndexerById(instance.Auth, int32(indexer.ID.ValueInt64())).Execute()
+	if err != nil {
+		helpers.HandleReadError(ctx, indexerNewznabResourceName, err, httpResp, resp)
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+indexerNewznabResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	indexer.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+}
+
+func (r *IndexerNewznabResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var indexer *IndexerNewznab
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.data.instance(indexer.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	// Update IndexerNewznab
+	request := indexer.read(ctx, &resp.Diagnostics)
+
+	if indexer.TestOnApply.ValueBool() {
+		if _, err = instance.Client.IndexerAPI.TestIndexer(instance.Auth).IndexerResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, indexerNewznabResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.IndexerAPI.UpdateIndexer(instance.Auth, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerNewznabResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+indexerNewznabResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	indexer.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+}
+
+func (r *IndexerNewznabResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var (
+		ID   int64
+		name types.String
+	)
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	// Delete IndexerNewznab current value
+	_, err = instance.Client.IndexerAPI.DeleteIndexer(instance.Auth, int32(ID)).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerNewznabResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "deleted "+indexerNewznabResourceName+": "+strconv.Itoa(int(ID)))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *IndexerNewznabResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importInstance(ctx, r.data, req.ID, resp)
+	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	tflog.Trace(ctx, "imported "+indexerNewznabResourceName+": "+req.ID)
+}
+
+func (i *IndexerNewznab) write(ctx context.Context, indexer *prowlarr.IndexerResource, diags *diag.Diagnostics) {
+	genericIndexer := i.toIndexer()
+	genericIndexer.write(ctx, indexer, diags)
+	i.fromIndexer(genericIndexer)
+	i.Categories = writeIndexerCategories(ctx, indexer, diags)
+	helpers.WriteFields(ctx, i, indexer.GetFields(), indexerNewznabFields)
+}
+
+func (i *IndexerNewznab) read(ctx context.Context, diags *diag.Diagnostics) *prowlarr.IndexerResource {
+	indexer := i.toIndexer().read(ctx, diags)
+	indexer.SetFields(helpers.ReadFields(ctx, i, indexerNewznabFields))
+
+	return indexer
+}
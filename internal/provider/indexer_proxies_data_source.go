@@ -4,11 +4,10 @@ import (
 	"context"
 	"strconv"
 
-	"github.com/devopsarr/prowlarr-go/prowlarr"
 	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
-
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,12 +23,12 @@ func NewIndexerProxiesDataSource() datasource.DataSource {
 
 // IndexerProxiesDataSource defines the indexer proxies implementation.
 type IndexerProxiesDataSource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // IndexerProxies describes the indexer proxies data model.
 type IndexerProxies struct {
+	Instance       types.String `tfsdk:"instance"`
 	IndexerProxies types.Set    `tfsdk:"indexer_proxies"`
 	ID             types.String `tfsdk:"id"`
 }
@@ -43,6 +42,7 @@ func (d *IndexerProxiesDataSource) Schema(_ context.Context, _ datasource.Schema
 		// This description is used by the documentation generator and the delay server.
 		MarkdownDescription: "<!-- subcategory:Indexer Proxies -->\nList all available [Indexer Proxies](../resources/indexer_proxy).",
 		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
 			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -103,15 +103,28 @@ func (d *IndexerProxiesDataSource) Schema(_ context.Context, _ datasource.Schema
 }
 
 func (d *IndexerProxiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
-		d.client = client
-		d.auth = auth
-	}
+	d.data = dataSourceConfigure(ctx, req, resp)
 }
 
-func (d *IndexerProxiesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *IndexerProxiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the instance from config
+	var name types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := d.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get indexer proxies current value
-	response, _, err := d.client.IndexerProxyAPI.ListIndexerProxy(d.auth).Execute()
+	response, _, err := instance.Client.IndexerProxyAPI.ListIndexerProxy(instance.Auth).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerProxiesDataSourceName, err))
 
@@ -127,5 +140,5 @@ func (d *IndexerProxiesDataSource) Read(ctx context.Context, _ datasource.ReadRe
 
 	proxyList, diags := types.SetValueFrom(ctx, IndexerProxy{}.getType(), proxies)
 	resp.Diagnostics.Append(diags...)
-	resp.Diagnostics.Append(resp.State.Set(ctx, IndexerProxies{IndexerProxies: proxyList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, IndexerProxies{Instance: name, IndexerProxies: proxyList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
 }
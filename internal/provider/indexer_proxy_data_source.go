@@ -5,10 +5,10 @@ import (
 
 	"github.com/devopsarr/prowlarr-go/prowlarr"
 	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
-
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +24,13 @@ func NewIndexerProxyDataSource() datasource.DataSource {
 
 // IndexerProxyDataSource defines the indexer_proxy implementation.
 type IndexerProxyDataSource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
+}
+
+// InstanceIndexerProxy extends IndexerProxy with the instance attribute, which the list elements do not have.
+type InstanceIndexerProxy struct {
+	Instance types.String `tfsdk:"instance"`
+	IndexerProxy
 }
 
 func (d *IndexerProxyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -37,6 +42,7 @@ func (d *IndexerProxyDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 		// This description is used by the documentation generator and the delay server.
 		MarkdownDescription: "<!-- subcategory:Indexer Proxies -->\nSingle [Indexer Proxy](../resources/indexer_proxy).",
 		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
 			"config_contract": schema.StringAttribute{
 				MarkdownDescription: "IndexerProxy configuration template.",
 				Computed:            true,
@@ -85,22 +91,26 @@ func (d *IndexerProxyDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 }
 
 func (d *IndexerProxyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
-		d.client = client
-		d.auth = auth
-	}
+	d.data = dataSourceConfigure(ctx, req, resp)
 }
 
 func (d *IndexerProxyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data *IndexerProxy
+	var data *InstanceIndexerProxy
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	instance, err := d.data.instance(data.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
 	// Get indexerProxy current value
-	response, _, err := d.client.IndexerProxyAPI.ListIndexerProxy(d.auth).Execute()
+	response, _, err := instance.Client.IndexerProxyAPI.ListIndexerProxy(instance.Auth).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerProxyDataSourceName, err))
 
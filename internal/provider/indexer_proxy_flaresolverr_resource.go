@@ -35,17 +35,18 @@ func NewIndexerProxyFlaresolverrResource() resource.Resource {
 
 // IndexerProxyFlaresolverrResource defines the indexer proxy implementation.
 type IndexerProxyFlaresolverrResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // IndexerProxyFlaresolverr describes the indexer proxy data model.
 type IndexerProxyFlaresolverr struct {
+	Instance       types.String `tfsdk:"instance"`
 	Tags           types.Set    `tfsdk:"tags"`
 	Name           types.String `tfsdk:"name"`
 	Host           types.String `tfsdk:"host"`
 	RequestTimeout types.Int64  `tfsdk:"request_timeout"`
 	ID             types.Int64  `tfsdk:"id"`
+	TestOnApply    types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (i IndexerProxyFlaresolverr) toIndexerProxy() *IndexerProxy {
@@ -76,6 +77,11 @@ func (r *IndexerProxyFlaresolverrResource) Schema(_ context.Context, _ resource.
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexer Proxies -->\nIndexer Proxy Flaresolverr resource.\nFor more information refer to [Indexer Proxy](https://wiki.servarr.com/prowlarr/settings#indexer-proxies) and [Flaresolverr](https://wiki.servarr.com/prowlarr/supported#flaresolverr).",
 		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Test the configuration against Prowlarr before applying it.",
+				Optional:            true,
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Indexer Proxy name.",
 				Required:            true,
@@ -107,10 +113,7 @@ func (r *IndexerProxyFlaresolverrResource) Schema(_ context.Context, _ resource.
 }
 
 func (r *IndexerProxyFlaresolverrResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
-	}
+	r.data = resourceConfigure(ctx, req, resp)
 }
 
 func (r *IndexerProxyFlaresolverrResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -123,10 +126,27 @@ func (r *IndexerProxyFlaresolverrResource) Create(ctx context.Context, req resou
 		return
 	}
 
+	instance, err := r.data.instance(proxy.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Create new IndexerProxyFlaresolverr
 	request := proxy.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerProxyAPI.CreateIndexerProxy(r.auth).IndexerProxyResource(*request).Execute()
+	if proxy.TestOnApply.ValueBool() {
+		if _, err = instance.Client.IndexerProxyAPI.TestIndexerProxy(instance.Auth).IndexerProxyResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, indexerProxyFlaresolverrResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.IndexerProxyAPI.CreateIndexerProxy(instance.Auth).IndexerProxyResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerProxyFlaresolverrResourceName, err))
 
@@ -149,10 +169,17 @@ func (r *IndexerProxyFlaresolverrResource) Read(ctx context.Context, req resourc
 		return
 	}
 
+	instance, err := r.data.instance(proxy.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get IndexerProxyFlaresolverr current value
-	response, _, err := r.client.IndexerProxyAPI.GetIndexerProxyById(r.auth, int32(proxy.ID.ValueInt64())).Execute()
+	response, httpResp, err := instance.Client.IndexerProxyAPI.GetIndexerProxyById(instance.Auth, int32(proxy.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerProxyFlaresolverrResourceName, err))
+		helpers.HandleReadError(ctx, indexerProxyFlaresolverrResourceName, err, httpResp, resp)
 
 		return
 	}
@@ -173,10 +200,27 @@ func (r *IndexerProxyFlaresolverrResource) Update(ctx context.Context, req resou
 		return
 	}
 
+	instance, err := r.data.instance(proxy.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Update IndexerProxyFlaresolverr
 	request := proxy.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerProxyAPI.UpdateIndexerProxy(r.auth, strconv.Itoa(int(request.GetId()))).IndexerProxyResource(*request).Execute()
+	if proxy.TestOnApply.ValueBool() {
+		if _, err = instance.Client.IndexerProxyAPI.TestIndexerProxy(instance.Auth).IndexerProxyResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, indexerProxyFlaresolverrResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.IndexerProxyAPI.UpdateIndexerProxy(instance.Auth, strconv.Itoa(int(request.GetId()))).IndexerProxyResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerProxyFlaresolverrResourceName, err))
 
@@ -190,16 +234,27 @@ func (r *IndexerProxyFlaresolverrResource) Update(ctx context.Context, req resou
 }
 
 func (r *IndexerProxyFlaresolverrResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID   int64
+		name types.String
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	instance, err := r.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Delete IndexerProxyFlaresolverr current value
-	_, err := r.client.IndexerProxyAPI.DeleteIndexerProxy(r.auth, int32(ID)).Execute()
+	_, err = instance.Client.IndexerProxyAPI.DeleteIndexerProxy(instance.Auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerProxyFlaresolverrResourceName, err))
 
@@ -211,6 +266,7 @@ func (r *IndexerProxyFlaresolverrResource) Delete(ctx context.Context, req resou
 }
 
 func (r *IndexerProxyFlaresolverrResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importInstance(ctx, r.data, req.ID, resp)
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
 	tflog.Trace(ctx, "imported "+indexerProxyFlaresolverrResourceName+": "+req.ID)
 }
@@ -1,11 +1,22 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"regexp"
+	"sync/atomic"
 	"testing"
 
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccIndexerProxyFlaresolverrResource(t *testing.T) {
@@ -59,3 +70,69 @@ func testAccIndexerProxyFlaresolverrResourceConfig(name string, timeout int) str
 		request_timeout = %d
 	}`, name, timeout)
 }
+
+// TestIndexerProxyFlaresolverrResourceTestOnApplyWarning checks that a test returning only warnings does not stop the apply.
+func TestIndexerProxyFlaresolverrResourceTestOnApplyWarning(t *testing.T) {
+	t.Parallel()
+
+	var saved atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/api/v1/indexerproxy/test" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`[{"propertyName":"Host","errorMessage":"Host is slow","isWarning":true}]`))
+
+			return
+		}
+
+		saved.Add(1)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"id":1,"name":"warning","tags":[],"fields":[{"name":"host","value":"http://localhost:8191/"},{"name":"requestTimeout","value":10}]}`))
+	}))
+	defer server.Close()
+
+	parsed, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	r := &IndexerProxyFlaresolverrResource{data: &ProwlarrData{
+		Client: prowlarr.NewAPIClient(prowlarr.NewConfiguration()),
+		Auth: context.WithValue(ctx, prowlarr.ContextServerVariables, map[string]string{
+			"protocol": parsed.Scheme,
+			"hostpath": parsed.Host,
+		}),
+	}}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	assert.False(t, plan.Set(ctx, &IndexerProxyFlaresolverr{
+		Tags:           types.SetNull(types.Int64Type),
+		Name:           types.StringValue("warning"),
+		Host:           types.StringValue("http://localhost:8191/"),
+		RequestTimeout: types.Int64Value(10),
+		ID:             types.Int64Value(1),
+		TestOnApply:    types.BoolValue(true),
+	}).HasError())
+
+	emptyState := func() tfsdk.State {
+		return tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	}
+
+	createResp := &fwresource.CreateResponse{State: emptyState()}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, createResp)
+	assert.False(t, createResp.Diagnostics.HasError())
+	assert.Equal(t, 1, createResp.Diagnostics.WarningsCount())
+	assert.False(t, createResp.State.Raw.IsNull())
+
+	updateResp := &fwresource.UpdateResponse{State: emptyState()}
+	r.Update(ctx, fwresource.UpdateRequest{Plan: plan}, updateResp)
+	assert.False(t, updateResp.Diagnostics.HasError())
+	assert.Equal(t, 1, updateResp.Diagnostics.WarningsCount())
+	assert.False(t, updateResp.State.Raw.IsNull())
+
+	assert.Equal(t, int32(2), saved.Load())
+}
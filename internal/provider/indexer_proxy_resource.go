@@ -37,8 +37,7 @@ func NewIndexerProxyResource() resource.Resource {
 
 // IndexerProxyResource defines the indexer proxy implementation.
 type IndexerProxyResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // IndexerProxy describes the indexer proxy data model.
@@ -55,6 +54,13 @@ type IndexerProxy struct {
 	ID             types.Int64  `tfsdk:"id"`
 }
 
+// TestableIndexerProxy extends IndexerProxy with the resource only test flag.
+type TestableIndexerProxy struct {
+	Instance types.String `tfsdk:"instance"`
+	IndexerProxy
+	TestOnApply types.Bool `tfsdk:"test_on_apply"`
+}
+
 func (i IndexerProxy) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -79,6 +85,11 @@ func (r *IndexerProxyResource) Schema(_ context.Context, _ resource.SchemaReques
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexer Proxies -->\nGeneric Indexer Proxy resource. When possible use a specific resource instead.\nFor more information refer to [Indexer Proxy](https://wiki.servarr.com/prowlarr/settings#indexer-proxies).",
 		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Test the configuration against Prowlarr before applying it.",
+				Optional:            true,
+			},
 			"config_contract": schema.StringAttribute{
 				MarkdownDescription: "IndexerProxy configuration template.",
 				Required:            true,
@@ -136,15 +147,12 @@ func (r *IndexerProxyResource) Schema(_ context.Context, _ resource.SchemaReques
 }
 
 func (r *IndexerProxyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
-	}
+	r.data = resourceConfigure(ctx, req, resp)
 }
 
 func (r *IndexerProxyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var proxy *IndexerProxy
+	var proxy *TestableIndexerProxy
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &proxy)...)
 
@@ -152,10 +160,27 @@ func (r *IndexerProxyResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	instance, err := r.data.instance(proxy.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Create new IndexerProxy
 	request := proxy.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerProxyAPI.CreateIndexerProxy(r.auth).IndexerProxyResource(*request).Execute()
+	if proxy.TestOnApply.ValueBool() {
+		if _, err = instance.Client.IndexerProxyAPI.TestIndexerProxy(instance.Auth).IndexerProxyResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, indexerProxyResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.IndexerProxyAPI.CreateIndexerProxy(instance.Auth).IndexerProxyResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerProxyResourceName, err))
 
@@ -165,16 +190,17 @@ func (r *IndexerProxyResource) Create(ctx context.Context, req resource.CreateRe
 	tflog.Trace(ctx, "created "+indexerProxyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state IndexerProxy
+	var state TestableIndexerProxy
 
-	state.writeSensitive(proxy)
+	state.TestOnApply = proxy.TestOnApply
+	state.writeSensitive(&proxy.IndexerProxy)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *IndexerProxyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var proxy *IndexerProxy
+	var proxy *TestableIndexerProxy
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &proxy)...)
 
@@ -182,10 +208,17 @@ func (r *IndexerProxyResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	instance, err := r.data.instance(proxy.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get IndexerProxy current value
-	response, _, err := r.client.IndexerProxyAPI.GetIndexerProxyById(r.auth, int32(proxy.ID.ValueInt64())).Execute()
+	response, httpResp, err := instance.Client.IndexerProxyAPI.GetIndexerProxyById(instance.Auth, int32(proxy.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerProxyResourceName, err))
+		helpers.HandleReadError(ctx, indexerProxyResourceName, err, httpResp, resp)
 
 		return
 	}
@@ -193,16 +226,17 @@ func (r *IndexerProxyResource) Read(ctx context.Context, req resource.ReadReques
 	tflog.Trace(ctx, "read "+indexerProxyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state IndexerProxy
+	var state TestableIndexerProxy
 
-	state.writeSensitive(proxy)
+	state.TestOnApply = proxy.TestOnApply
+	state.writeSensitive(&proxy.IndexerProxy)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *IndexerProxyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var proxy *IndexerProxy
+	var proxy *TestableIndexerProxy
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &proxy)...)
 
@@ -210,10 +244,27 @@ func (r *IndexerProxyResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	instance, err := r.data.instance(proxy.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Update IndexerProxy
 	request := proxy.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerProxyAPI.UpdateIndexerProxy(r.auth, strconv.Itoa(int(request.GetId()))).IndexerProxyResource(*request).Execute()
+	if proxy.TestOnApply.ValueBool() {
+		if _, err = instance.Client.IndexerProxyAPI.TestIndexerProxy(instance.Auth).IndexerProxyResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, indexerProxyResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.IndexerProxyAPI.UpdateIndexerProxy(instance.Auth, strconv.Itoa(int(request.GetId()))).IndexerProxyResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerProxyResourceName, err))
 
@@ -223,24 +274,36 @@ func (r *IndexerProxyResource) Update(ctx context.Context, req resource.UpdateRe
 	tflog.Trace(ctx, "updated "+indexerProxyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state IndexerProxy
+	var state TestableIndexerProxy
 
-	state.writeSensitive(proxy)
+	state.TestOnApply = proxy.TestOnApply
+	state.writeSensitive(&proxy.IndexerProxy)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *IndexerProxyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID   int64
+		name types.String
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	instance, err := r.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Delete IndexerProxy current value
-	_, err := r.client.IndexerProxyAPI.DeleteIndexerProxy(r.auth, int32(ID)).Execute()
+	_, err = instance.Client.IndexerProxyAPI.DeleteIndexerProxy(instance.Auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerProxyResourceName, err))
 
@@ -252,6 +315,7 @@ func (r *IndexerProxyResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *IndexerProxyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importInstance(ctx, r.data, req.ID, resp)
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
 	tflog.Trace(ctx, "imported "+indexerProxyResourceName+": "+req.ID)
 }
@@ -35,19 +35,20 @@ func NewIndexerProxySocks5Resource() resource.Resource {
 
 // IndexerProxySocks5Resource defines the indexer proxy implementation.
 type IndexerProxySocks5Resource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // IndexerProxySocks5 describes the indexer proxy data model.
 type IndexerProxySocks5 struct {
-	Tags     types.Set    `tfsdk:"tags"`
-	Name     types.String `tfsdk:"name"`
-	Host     types.String `tfsdk:"host"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
-	Port     types.Int64  `tfsdk:"port"`
-	ID       types.Int64  `tfsdk:"id"`
+	Instance    types.String `tfsdk:"instance"`
+	Tags        types.Set    `tfsdk:"tags"`
+	Name        types.String `tfsdk:"name"`
+	Host        types.String `tfsdk:"host"`
+	Username    types.String `tfsdk:"username"`
+	Password    types.String `tfsdk:"password"`
+	Port        types.Int64  `tfsdk:"port"`
+	ID          types.Int64  `tfsdk:"id"`
+	TestOnApply types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (i IndexerProxySocks5) toIndexerProxy() *IndexerProxy {
@@ -82,6 +83,11 @@ func (r *IndexerProxySocks5Resource) Schema(_ context.Context, _ resource.Schema
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexer Proxies -->\nIndexer Proxy Socks5 resource.\nFor more information refer to [Indexer Proxy](https://wiki.servarr.com/prowlarr/settings#indexer-proxies) and [Socks5](https://wiki.servarr.com/prowlarr/supported#socks5).",
 		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Test the configuration against Prowlarr before applying it.",
+				Optional:            true,
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Indexer Proxy name.",
 				Required:            true,
@@ -122,10 +128,7 @@ func (r *IndexerProxySocks5Resource) Schema(_ context.Context, _ resource.Schema
 }
 
 func (r *IndexerProxySocks5Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
-	}
+	r.data = resourceConfigure(ctx, req, resp)
 }
 
 func (r *IndexerProxySocks5Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -138,10 +141,27 @@ func (r *IndexerProxySocks5Resource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	instance, err := r.data.instance(proxy.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Create new IndexerProxySocks5
 	request := proxy.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerProxyAPI.CreateIndexerProxy(r.auth).IndexerProxyResource(*request).Execute()
+	if proxy.TestOnApply.ValueBool() {
+		if _, err = instance.Client.IndexerProxyAPI.TestIndexerProxy(instance.Auth).IndexerProxyResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, indexerProxySocks5ResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.IndexerProxyAPI.CreateIndexerProxy(instance.Auth).IndexerProxyResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerProxySocks5ResourceName, err))
 
@@ -164,10 +184,17 @@ func (r *IndexerProxySocks5Resource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	instance, err := r.data.instance(proxy.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get IndexerProxySocks5 current value
-	response, _, err := r.client.IndexerProxyAPI.GetIndexerProxyById(r.auth, int32(proxy.ID.ValueInt64())).Execute()
+	response, httpResp, err := instance.Client.IndexerProxyAPI.GetIndexerProxyById(instance.Auth, int32(proxy.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerProxySocks5ResourceName, err))
+		helpers.HandleReadError(ctx, indexerProxySocks5ResourceName, err, httpResp, resp)
 
 		return
 	}
@@ -188,10 +215,27 @@ func (r *IndexerProxySocks5Resource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	instance, err := r.data.instance(proxy.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Update IndexerProxySocks5
 	request := proxy.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerProxyAPI.UpdateIndexerProxy(r.auth, strconv.Itoa(int(request.GetId()))).IndexerProxyResource(*request).Execute()
+	if proxy.TestOnApply.ValueBool() {
+		if _, err = instance.Client.IndexerProxyAPI.TestIndexerProxy(instance.Auth).IndexerProxyResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, indexerProxySocks5ResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.IndexerProxyAPI.UpdateIndexerProxy(instance.Auth, strconv.Itoa(int(request.GetId()))).IndexerProxyResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerProxySocks5ResourceName, err))
 
@@ -205,16 +249,27 @@ func (r *IndexerProxySocks5Resource) Update(ctx context.Context, req resource.Up
 }
 
 func (r *IndexerProxySocks5Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID   int64
+		name types.String
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	instance, err := r.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Delete IndexerProxySocks5 current value
-	_, err := r.client.IndexerProxyAPI.DeleteIndexerProxy(r.auth, int32(ID)).Execute()
+	_, err = instance.Client.IndexerProxyAPI.DeleteIndexerProxy(instance.Auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerProxySocks5ResourceName, err))
 
@@ -226,6 +281,7 @@ func (r *IndexerProxySocks5Resource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *IndexerProxySocks5Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importInstance(ctx, r.data, req.ID, resp)
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
 	tflog.Trace(ctx, "imported "+indexerProxySocks5ResourceName+": "+req.ID)
 }
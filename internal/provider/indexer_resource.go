@@ -2,12 +2,16 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"math/big"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/prowlarr-go/prowlarr"
 	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,26 +19,45 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-const indexerResourceName = "indexer"
+const (
+	indexerResourceName            = "indexer"
+	indexerCardigannImplementation = "Cardigann"
+)
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &IndexerResource{}
-	_ resource.ResourceWithImportState = &IndexerResource{}
+	_ resource.Resource                   = &IndexerResource{}
+	_ resource.ResourceWithImportState    = &IndexerResource{}
+	_ resource.ResourceWithModifyPlan     = &IndexerResource{}
+	_ resource.ResourceWithValidateConfig = &IndexerResource{}
 )
 
+// cardigannFieldValues maps each Cardigann field type to the value attributes it accepts.
+// Select fields hold either a string or a number, see cardigannAllowedValues.
+var cardigannFieldValues = map[string][]string{
+	"textbox":  {"text_value", "sensitive_value"},
+	"password": {"text_value", "sensitive_value"},
+	"checkbox": {"bool_value"},
+	"number":   {"number_value"},
+	"select":   {"text_value", "number_value", "set_value"},
+}
+
+// secretFieldNames lists the name fragments of fields which usually hold a secret.
+var secretFieldNames = []string{"apikey", "api_key", "passkey", "password", "cookie", "token", "secret"}
+
 func NewIndexerResource() resource.Resource {
 	return &IndexerResource{}
 }
 
 // IndexerResource defines the indexer implementation.
 type IndexerResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // Indexer describes the indexer data model.
@@ -55,6 +78,15 @@ type Indexer struct {
 	Redirect       types.Bool   `tfsdk:"redirect"`
 }
 
+// TestableIndexer extends Indexer with the resource only attributes.
+type TestableIndexer struct {
+	Instance          types.String `tfsdk:"instance"`
+	SensitiveValuesWO types.Map    `tfsdk:"sensitive_values_wo"`
+	Indexer
+	SensitiveValuesWOVersion types.Int64 `tfsdk:"sensitive_values_wo_version"`
+	TestOnApply              types.Bool  `tfsdk:"test_on_apply"`
+}
+
 // Field is part of Indexer.
 type Field struct {
 	SetValue       types.Set    `tfsdk:"set_value"`
@@ -71,8 +103,13 @@ func (r *IndexerResource) Metadata(_ context.Context, req resource.MetadataReque
 
 func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "<!-- subcategory:Indexers -->\nGeneric Indexer resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/prowlarr/indexers) documentation.",
+		MarkdownDescription: "<!-- subcategory:Indexers -->\nGeneric Indexer resource.\nFields of `Cardigann` indexers are validated at plan time against the schema of their `definitionFile`.\nFor more information refer to [Indexer](https://wiki.servarr.com/prowlarr/indexers) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Test the configuration against Prowlarr before applying it.",
+				Optional:            true,
+			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -134,11 +171,19 @@ func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			},
 			"fields": schema.SetNestedAttribute{
 				Required:            true,
-				MarkdownDescription: "Set of configuration fields. All non-empty fields must be specified.",
+				MarkdownDescription: "Set of configuration fields. All non-empty fields must be specified, each one with a unique name and exactly one value.",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: r.getFieldSchema().Attributes,
 				},
 			},
+			"sensitive_values_wo": schema.MapAttribute{
+				MarkdownDescription: "Sensitive field values by field name. Write only alternative to `sensitive_value`, it is never stored in state. Requires Terraform 1.11 or later.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				ElementType:         types.StringType,
+			},
+			"sensitive_values_wo_version": writeOnlyVersionAttribute("sensitive_values_wo"),
 		},
 	}
 }
@@ -182,15 +227,12 @@ func (r IndexerResource) getFieldSchema() schema.Schema {
 }
 
 func (r *IndexerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
-	}
+	r.data = resourceConfigure(ctx, req, resp)
 }
 
 func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var indexer *Indexer
+	var indexer *TestableIndexer
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
 
@@ -198,10 +240,28 @@ func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	instance, err := r.data.instance(indexer.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Create new Indexer
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.SetFields(readSensitiveValuesWO(ctx, req.Config, request.GetFields(), &resp.Diagnostics))
+
+	if indexer.TestOnApply.ValueBool() {
+		if _, err = instance.Client.IndexerAPI.TestIndexer(instance.Auth).IndexerResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, indexerResourceName, err, req.Plan, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.IndexerAPI.CreateIndexer(instance.Auth).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerResourceName, err))
 
@@ -216,7 +276,7 @@ func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest
 
 func (r *IndexerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var indexer *Indexer
+	var indexer *TestableIndexer
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &indexer)...)
 
@@ -224,10 +284,17 @@ func (r *IndexerResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	instance, err := r.data.instance(indexer.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get Indexer current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := instance.Client.IndexerAPI.GetIndexerById(instance.Auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerResourceName, err))
+		helpers.HandleReadError(ctx, indexerResourceName, err, httpResp, resp)
 
 		return
 	}
@@ -240,7 +307,7 @@ func (r *IndexerResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 func (r *IndexerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var indexer *Indexer
+	var indexer *TestableIndexer
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
 
@@ -248,10 +315,28 @@ func (r *IndexerResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	instance, err := r.data.instance(indexer.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Update Indexer
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.SetFields(readSensitiveValuesWO(ctx, req.Config, request.GetFields(), &resp.Diagnostics))
+
+	if indexer.TestOnApply.ValueBool() {
+		if _, err = instance.Client.IndexerAPI.TestIndexer(instance.Auth).IndexerResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, indexerResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
+	response, _, err := instance.Client.IndexerAPI.UpdateIndexer(instance.Auth, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerResourceName, err))
 
@@ -265,16 +350,27 @@ func (r *IndexerResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *IndexerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID   int64
+		name types.String
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	instance, err := r.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Delete Indexer current value
-	_, err := r.client.IndexerAPI.DeleteIndexer(r.auth, int32(ID)).Execute()
+	_, err = instance.Client.IndexerAPI.DeleteIndexer(instance.Auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerResourceName, err))
 
@@ -285,7 +381,76 @@ func (r *IndexerResource) Delete(ctx context.Context, req resource.DeleteRequest
 	resp.State.RemoveResource(ctx)
 }
 
+func (r *IndexerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var (
+		fieldSet  types.Set
+		writeOnly types.Map
+	)
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("fields"), &fieldSet)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("sensitive_values_wo"), &writeOnly)...)
+
+	if resp.Diagnostics.HasError() || fieldSet.IsNull() || fieldSet.IsUnknown() {
+		return
+	}
+
+	validateIndexerFields(configuredFields(ctx, fieldSet, &resp.Diagnostics), writeOnly, &resp.Diagnostics)
+}
+
+func (r *IndexerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy or if the provider is not configured yet.
+	if req.Plan.Raw.IsNull() || r.data == nil {
+		return
+	}
+
+	// Config is used instead of plan, since unset values are unknown in plan.
+	var indexer *TestableIndexer
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &indexer)...)
+
+	if resp.Diagnostics.HasError() || indexer.Implementation.ValueString() != indexerCardigannImplementation || indexer.Fields.IsUnknown() || indexer.Instance.IsUnknown() {
+		return
+	}
+
+	fields := configuredFields(ctx, indexer.Fields, &resp.Diagnostics)
+	definition, definitionPath := "", path.Root("fields")
+
+	for _, f := range fields {
+		if f.Name.ValueString() == "definitionFile" {
+			definition, definitionPath = f.TextValue.ValueString(), f.path
+		}
+	}
+
+	if definition == "" {
+		return
+	}
+
+	instance, err := r.data.instance(indexer.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	schemas, err := instance.IndexerSchemas()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, indexerResourceName+" schemas", err))
+
+		return
+	}
+
+	definitionSchema := findCardigannSchema(schemas, definition)
+	if definitionSchema == nil {
+		resp.Diagnostics.AddAttributeError(definitionPath, helpers.ResourceError, fmt.Sprintf("Cardigann definition '%s' does not exist", definition))
+
+		return
+	}
+
+	validateCardigannFields(definition, definitionSchema.GetFields(), fields, &resp.Diagnostics)
+}
+
 func (r *IndexerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importInstance(ctx, r.data, req.ID, resp)
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
 	tflog.Trace(ctx, "imported "+indexerResourceName+": "+req.ID)
 }
@@ -315,6 +480,11 @@ func (i *Indexer) write(ctx context.Context, indexer *prowlarr.IndexerResource,
 			continue
 		}
 
+		// Sensitive values set through sensitive_values_wo are not part of the fields.
+		if f.GetValue() == helpers.SensitiveValue && !i.Fields.IsNull() && !i.hasField(ctx, f.GetName(), diags) {
+			continue
+		}
+
 		if _, ok := f.GetValueOk(); ok {
 			var field Field
 
@@ -381,6 +551,26 @@ func (i *Indexer) findSensitive(ctx context.Context, name string, diags *diag.Di
 	return types.StringValue("")
 }
 
+func (i *Indexer) hasField(ctx context.Context, name string, diags *diag.Diagnostics) bool {
+	fieldList := make([]Field, len(i.Fields.Elements()))
+	diags.Append(i.Fields.ElementsAs(ctx, &fieldList, true)...)
+
+	return slices.ContainsFunc(fieldList, func(f Field) bool { return f.Name.ValueString() == name })
+}
+
+// readSensitiveValuesWO sets the write only sensitive values into the API fields.
+// Write only values are not part of plan and state, so they are read from the configuration.
+func readSensitiveValuesWO(ctx context.Context, config tfsdk.Config, fields []prowlarr.Field, diags *diag.Diagnostics) []prowlarr.Field {
+	var values types.Map
+
+	diags.Append(config.GetAttribute(ctx, path.Root("sensitive_values_wo"), &values)...)
+
+	sensitive := make(map[string]types.String, len(values.Elements()))
+	diags.Append(values.ElementsAs(ctx, &sensitive, true)...)
+
+	return helpers.SetWriteOnlyFields(fields, sensitive)
+}
+
 func (i *Indexer) read(ctx context.Context, diags *diag.Diagnostics) *prowlarr.IndexerResource {
 	fieldList := make([]Field, len(i.Fields.Elements()))
 	diags.Append(i.Fields.ElementsAs(ctx, &fieldList, true)...)
@@ -434,3 +624,202 @@ func (f *Field) read(ctx context.Context, diags *diag.Diagnostics) prowlarr.Fiel
 
 	return *field
 }
+
+// writeIndexerCategories extracts the category IDs, sub categories included, from the indexer capabilities.
+func writeIndexerCategories(ctx context.Context, indexer *prowlarr.IndexerResource, diags *diag.Diagnostics) types.Set {
+	categories := make([]int64, 0)
+
+	capabilities := indexer.GetCapabilities()
+	for _, c := range capabilities.GetCategories() {
+		categories = append(categories, int64(c.GetId()))
+
+		for _, s := range c.GetSubCategories() {
+			categories = append(categories, int64(s.GetId()))
+		}
+	}
+
+	set, localDiag := types.SetValueFrom(ctx, types.Int64Type, categories)
+	diags.Append(localDiag...)
+
+	return set
+}
+
+// findCardigannSchema returns the Cardigann schema matching the given definition file.
+func findCardigannSchema(schemas []prowlarr.IndexerResource, definition string) *prowlarr.IndexerResource {
+	for i, s := range schemas {
+		if s.GetImplementation() != indexerCardigannImplementation {
+			continue
+		}
+
+		for _, f := range s.GetFields() {
+			if f.GetName() == "definitionFile" && f.GetValue() == definition {
+				return &schemas[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+// configuredField is a configured field with the path of its set element, to report diagnostics on the field itself.
+type configuredField struct {
+	path path.Path
+	Field
+}
+
+// configuredFields returns the fields of the set, each one with its own path.
+func configuredFields(ctx context.Context, fieldSet types.Set, diags *diag.Diagnostics) []configuredField {
+	elements := fieldSet.Elements()
+	fields := make([]configuredField, len(elements))
+
+	for n, element := range elements {
+		fields[n].path = path.Root("fields").AtSetValue(element)
+
+		if object, ok := element.(types.Object); ok {
+			diags.Append(object.As(ctx, &fields[n].Field, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})...)
+		}
+	}
+
+	return fields
+}
+
+// validateCardigannFields checks that each field exists in the definition and that its value matches the field type.
+func validateCardigannFields(definition string, schemaFields []prowlarr.Field, fields []configuredField, diags *diag.Diagnostics) {
+	names := make([]string, len(schemaFields))
+	definitionFields := make(map[string]prowlarr.Field, len(schemaFields))
+
+	for i, f := range schemaFields {
+		names[i] = f.GetName()
+		definitionFields[f.GetName()] = f
+	}
+
+	for _, f := range fields {
+		name := f.Name.ValueString()
+
+		definitionField, ok := definitionFields[name]
+		if !ok {
+			detail := fmt.Sprintf("Field '%s' is not supported by Cardigann definition '%s'.", name, definition)
+			if match, found := helpers.ClosestMatch(name, names); found {
+				detail += fmt.Sprintf(" Did you mean '%s'?", match)
+			}
+
+			diags.AddAttributeError(f.path, helpers.ResourceError, detail)
+
+			continue
+		}
+
+		allowed := cardigannAllowedValues(definitionField)
+		if allowed == nil {
+			continue
+		}
+
+		if value := f.valueAttribute(); value != "" && !slices.Contains(allowed, value) {
+			diags.AddAttributeError(f.path, helpers.ResourceError,
+				fmt.Sprintf("Field '%s' has type '%s' and it must be set using one of %v, got '%s'.", name, definitionField.GetType(), allowed, value))
+		}
+	}
+}
+
+// cardigannAllowedValues returns the value attributes accepted by a definition field, nil if any is accepted.
+// The value of select fields is used to tell string selects, like baseUrl, from number ones.
+func cardigannAllowedValues(field prowlarr.Field) []string {
+	if field.GetType() == "select" {
+		switch field.GetValue().(type) {
+		case string:
+			return []string{"text_value"}
+		case float64, int, int32, int64:
+			return []string{"number_value"}
+		case []interface{}:
+			return []string{"set_value"}
+		}
+	}
+
+	return cardigannFieldValues[field.GetType()]
+}
+
+// fieldValue is a value attribute of a Field.
+type fieldValue struct {
+	value attr.Value
+	name  string
+}
+
+// values returns all the value attributes of the field.
+func (f *Field) values() []fieldValue {
+	return []fieldValue{
+		{name: "text_value", value: f.TextValue},
+		{name: "sensitive_value", value: f.SensitiveValue},
+		{name: "number_value", value: f.NumberValue},
+		{name: "bool_value", value: f.BoolValue},
+		{name: "set_value", value: f.SetValue},
+	}
+}
+
+// valueAttribute returns the name of the first known and not null value attribute.
+func (f *Field) valueAttribute() string {
+	for _, v := range f.values() {
+		if !v.value.IsNull() && !v.value.IsUnknown() {
+			return v.name
+		}
+	}
+
+	return ""
+}
+
+// configuredValueAttributes returns the names of the not null value attributes, unknown ones included.
+func (f *Field) configuredValueAttributes() []string {
+	var names []string
+
+	for _, v := range f.values() {
+		if !v.value.IsNull() {
+			names = append(names, v.name)
+		}
+	}
+
+	return names
+}
+
+// validateIndexerFields checks that each field has exactly one value, a unique name and is not also set in sensitive_values_wo.
+// It also warns when a field that usually holds a secret is set through text_value.
+func validateIndexerFields(fields []configuredField, writeOnly types.Map, diags *diag.Diagnostics) {
+	// reported maps each name to whether it has already been reported as duplicate.
+	reported := make(map[string]bool, len(fields))
+
+	for _, f := range fields {
+		if f.Name.IsUnknown() {
+			continue
+		}
+
+		name := f.Name.ValueString()
+
+		switch done, seen := reported[name]; {
+		case !seen:
+			reported[name] = false
+		case !done:
+			diags.AddAttributeError(f.path, helpers.ResourceError, fmt.Sprintf("Field '%s' is defined more than once.", name))
+
+			reported[name] = true
+		}
+
+		if values := f.configuredValueAttributes(); len(values) != 1 {
+			diags.AddAttributeError(f.path, helpers.ResourceError,
+				fmt.Sprintf("Field '%s' must have exactly one value attribute (text_value, sensitive_value, number_value, bool_value or set_value), got %d %v.", name, len(values), values))
+		}
+
+		if _, ok := writeOnly.Elements()[name]; ok {
+			diags.AddAttributeError(f.path, helpers.ResourceError,
+				fmt.Sprintf("Field '%s' is also set in sensitive_values_wo, remove it from one of them.", name))
+		}
+
+		if !f.TextValue.IsNull() && isSecretFieldName(name) {
+			diags.AddAttributeWarning(f.path, "Possible Secret In Text Value",
+				fmt.Sprintf("Field '%s' looks like a secret, use sensitive_value instead of text_value to keep it out of the plan output.", name))
+		}
+	}
+}
+
+// isSecretFieldName reports whether the field name contains one of the secretFieldNames.
+func isSecretFieldName(name string) bool {
+	name = strings.ToLower(name)
+
+	return slices.ContainsFunc(secretFieldNames, func(s string) bool { return strings.Contains(name, s) })
+}
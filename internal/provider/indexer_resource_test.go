@@ -1,11 +1,20 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"math/big"
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccIndexerResource(t *testing.T) {
@@ -15,6 +24,16 @@ func TestAccIndexerResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Invalid fields
+			{
+				Config:      testAccIndexerResourceDuplicateFieldConfig,
+				ExpectError: regexp.MustCompile("Field 'baseUrl' is defined more than once"),
+			},
+			// Invalid Cardigann field
+			{
+				Config:      testAccIndexerResourceInvalidFieldConfig,
+				ExpectError: regexp.MustCompile("Did you mean 'baseUrl'"),
+			},
 			// Unauthorized Create
 			{
 				Config:      testAccIndexerResourceConfig("resourceTest", "https://0magnet.co/") + testUnauthorizedProvider,
@@ -155,3 +174,422 @@ func testAccIndexerResourceConfig(name, url string) string {
 	}
 	`, name, url, name)
 }
+
+const testAccIndexerResourceInvalidFieldConfig = `
+resource "prowlarr_indexer" "test" {
+	enable = false
+	name = "invalidFieldTest"
+	implementation = "Cardigann"
+	config_contract = "CardigannSettings"
+	protocol = "torrent"
+	app_profile_id = 1
+
+	fields = [
+		{
+			name = "definitionFile"
+			text_value = "0magnet"
+		},
+		{
+			name = "baseURL2"
+			text_value = "https://0magnet.co/"
+		}
+	]
+}
+`
+
+const testAccIndexerResourceDuplicateFieldConfig = `
+resource "prowlarr_indexer" "test" {
+	enable = false
+	name = "duplicateFieldTest"
+	implementation = "Cardigann"
+	config_contract = "CardigannSettings"
+	protocol = "torrent"
+	app_profile_id = 1
+
+	fields = [
+		{
+			name = "definitionFile"
+			text_value = "0magnet"
+		},
+		{
+			name = "baseUrl"
+			text_value = "https://0magnet.co/"
+		},
+		{
+			name = "baseUrl"
+			text_value = "https://13mag.net/"
+		}
+	]
+}
+`
+
+// testField returns a field with the given name and all values null.
+func testField(name string) Field {
+	return Field{
+		Name:           types.StringValue(name),
+		TextValue:      types.StringNull(),
+		SensitiveValue: types.StringNull(),
+		NumberValue:    types.NumberNull(),
+		BoolValue:      types.BoolNull(),
+		SetValue:       types.SetNull(types.Int64Type),
+	}
+}
+
+// testSchemaField returns an API field with the given name, type and value.
+func testSchemaField(name, fieldType string, value interface{}) prowlarr.Field {
+	field := prowlarr.NewField()
+	field.SetName(name)
+	field.SetType(fieldType)
+	field.SetValue(value)
+
+	return *field
+}
+
+// testConfiguredFields returns the fields as read from a configured set.
+func testConfiguredFields(t *testing.T, fields []Field) []configuredField {
+	t.Helper()
+
+	ctx := context.Background()
+
+	fieldSet, diags := types.SetValueFrom(ctx, IndexerResource{}.getFieldSchema().Type(), fields)
+	assert.False(t, diags.HasError())
+
+	configured := configuredFields(ctx, fieldSet, &diags)
+	assert.False(t, diags.HasError())
+
+	return configured
+}
+
+// assertFieldPaths checks that every diagnostic is reported on a fields element.
+func assertFieldPaths(t *testing.T, diags diag.Diagnostics) {
+	t.Helper()
+
+	for _, d := range diags {
+		withPath, ok := d.(diag.DiagnosticWithPath)
+		if assert.True(t, ok) {
+			assert.Len(t, withPath.Path().Steps(), 2, withPath.Path().String())
+			assert.True(t, withPath.Path().ParentPath().Equal(path.Root("fields")))
+		}
+	}
+}
+
+func TestFindCardigannSchema(t *testing.T) {
+	t.Parallel()
+
+	schema := func(implementation, definition string) prowlarr.IndexerResource {
+		indexer := prowlarr.NewIndexerResource()
+		indexer.SetImplementation(implementation)
+		indexer.SetFields([]prowlarr.Field{testSchemaField("definitionFile", "textbox", definition)})
+
+		return *indexer
+	}
+
+	schemas := []prowlarr.IndexerResource{
+		schema("Newznab", "0magnet"),
+		schema(indexerCardigannImplementation, "1337x"),
+		schema(indexerCardigannImplementation, "0magnet"),
+	}
+
+	tests := map[string]struct {
+		definition string
+		expected   int
+	}{
+		"found": {
+			definition: "0magnet",
+			expected:   2,
+		},
+		"first": {
+			definition: "1337x",
+			expected:   1,
+		},
+		"missing": {
+			definition: "missing",
+			expected:   -1,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			found := findCardigannSchema(schemas, test.definition)
+			if test.expected < 0 {
+				assert.Nil(t, found)
+
+				return
+			}
+
+			assert.Same(t, &schemas[test.expected], found)
+		})
+	}
+}
+
+func TestValidateCardigannFields(t *testing.T) {
+	t.Parallel()
+
+	schemaFields := []prowlarr.Field{
+		testSchemaField("definitionFile", "hidden", "0magnet"),
+		// selects hold either a string or a number, as decoded from the API
+		testSchemaField("baseUrl", "select", "https://0magnet.co/"),
+		testSchemaField("baseSettings.limitsUnit", "select", float64(0)),
+		testSchemaField("username", "textbox", ""),
+		testSchemaField("freeleech", "checkbox", false),
+		testSchemaField("baseSettings.queryLimit", "number", float64(0)),
+	}
+
+	definition := testField("definitionFile")
+	definition.TextValue = types.StringValue("0magnet")
+
+	url := testField("baseUrl")
+	url.TextValue = types.StringValue("https://0magnet.co/")
+
+	numberURL := testField("baseUrl")
+	numberURL.NumberValue = types.NumberValue(big.NewFloat(1))
+
+	unit := testField("baseSettings.limitsUnit")
+	unit.NumberValue = types.NumberValue(big.NewFloat(1))
+
+	textUnit := testField("baseSettings.limitsUnit")
+	textUnit.TextValue = types.StringValue("1")
+
+	username := testField("username")
+	username.SensitiveValue = types.StringValue("user")
+
+	freeleech := testField("freeleech")
+	freeleech.BoolValue = types.BoolValue(true)
+
+	limit := testField("baseSettings.queryLimit")
+	limit.NumberValue = types.NumberValue(big.NewFloat(2))
+
+	wrongType := testField("freeleech")
+	wrongType.TextValue = types.StringValue("true")
+
+	typo := testField("baseURL")
+	typo.TextValue = types.StringValue("https://0magnet.co/")
+
+	tests := map[string]struct {
+		fields []Field
+		errors int
+	}{
+		"valid": {
+			fields: []Field{definition, url, unit, username, freeleech, limit},
+		},
+		"string select as number": {
+			fields: []Field{definition, numberURL},
+			errors: 1,
+		},
+		"number select as text": {
+			fields: []Field{definition, textUnit},
+			errors: 1,
+		},
+		"no value": {
+			fields: []Field{testField("username")},
+		},
+		"wrong type": {
+			fields: []Field{definition, wrongType},
+			errors: 1,
+		},
+		"unsupported": {
+			fields: []Field{definition, typo},
+			errors: 1,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			validateCardigannFields("0magnet", schemaFields, testConfiguredFields(t, test.fields), &diags)
+			assert.Equal(t, test.errors, diags.ErrorsCount())
+			assertFieldPaths(t, diags)
+		})
+	}
+}
+
+func TestValidateIndexerFields(t *testing.T) {
+	t.Parallel()
+
+	text := testField("baseUrl")
+	text.TextValue = types.StringValue("https://0magnet.co/")
+
+	duplicate := testField("baseUrl")
+	duplicate.TextValue = types.StringValue("https://13mag.net/")
+
+	another := testField("baseUrl")
+	another.TextValue = types.StringValue("https://1337x.to/")
+
+	number := testField("baseSettings.queryLimit")
+	number.NumberValue = types.NumberValue(big.NewFloat(2))
+
+	sensitive := testField("apiKey")
+	sensitive.SensitiveValue = types.StringValue("secret")
+
+	unknown := testField("apiKey")
+	unknown.SensitiveValue = types.StringUnknown()
+
+	secret := testField("cookie")
+	secret.TextValue = types.StringValue("secret")
+
+	multiple := testField("username")
+	multiple.TextValue = types.StringValue("user")
+	multiple.BoolValue = types.BoolValue(true)
+
+	writeOnly := types.MapValueMust(types.StringType, map[string]attr.Value{"apiKey": types.StringValue("secret")})
+
+	tests := map[string]struct {
+		writeOnly types.Map
+		fields    []Field
+		errors    int
+		warnings  int
+	}{
+		"valid": {
+			fields: []Field{text, number, sensitive},
+		},
+		"write only": {
+			fields:    []Field{text, number},
+			writeOnly: writeOnly,
+		},
+		"write only conflict": {
+			fields:    []Field{text, sensitive},
+			writeOnly: writeOnly,
+			errors:    1,
+		},
+		"unknown value": {
+			fields: []Field{text, unknown},
+		},
+		"duplicate": {
+			fields: []Field{text, duplicate, another},
+			errors: 1,
+		},
+		"no value": {
+			fields: []Field{testField("baseUrl")},
+			errors: 1,
+		},
+		"multiple values": {
+			fields: []Field{multiple},
+			errors: 1,
+		},
+		"secret in text": {
+			fields:   []Field{secret},
+			warnings: 1,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			validateIndexerFields(testConfiguredFields(t, test.fields), test.writeOnly, &diags)
+			assert.Equal(t, test.errors, diags.ErrorsCount())
+			assert.Equal(t, test.warnings, diags.WarningsCount())
+			assertFieldPaths(t, diags)
+		})
+	}
+}
+
+func TestAccIndexerResourceWriteOnly(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccIndexerResourceWriteOnlyConfig("resourceWOTest", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("prowlarr_indexer.test", "sensitive_values_wo"),
+					resource.TestCheckNoResourceAttr("prowlarr_indexer.test", "sensitive_values_wo.apiKey"),
+					resource.TestCheckResourceAttr("prowlarr_indexer.test", "sensitive_values_wo_version", "1"),
+					resource.TestCheckResourceAttrSet("prowlarr_indexer.test", "id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccIndexerResourceWriteOnlyConfig("resourceWOTest", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("prowlarr_indexer.test", "sensitive_values_wo"),
+					resource.TestCheckNoResourceAttr("prowlarr_indexer.test", "sensitive_values_wo.apiKey"),
+					resource.TestCheckResourceAttr("prowlarr_indexer.test", "sensitive_values_wo_version", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccIndexerResourceWriteOnlyConfig(name string, version int) string {
+	return fmt.Sprintf(`
+	resource "prowlarr_indexer" "test" {
+		enable = false
+		name = "%s"
+		implementation = "HDBits"
+		config_contract = "HDBitsSettings"
+		protocol = "torrent"
+		app_profile_id = 1
+		priority = 2
+		tags = []
+
+		sensitive_values_wo = {
+			apiKey = "test%d"
+		}
+		sensitive_values_wo_version = %d
+
+		fields = [
+			{
+				name = "baseUrl"
+				text_value = "https://hdbits.org/"
+			},
+			{
+				name = "username"
+				text_value = "test"
+			},
+			{
+				name = "codecs"
+				set_value = [1,5]
+			},
+			{
+				name = "mediums"
+				set_value = [1,3]
+			},
+			{
+				name = "baseSettings.limitsUnit"
+				number_value = 0
+			},
+			{
+				name = "torrentBaseSettings.seedRatio"
+				number_value = 0.5
+			},
+			{
+				name = "torrentBaseSettings.seedTime"
+				number_value = 5
+			},
+			{
+				name = "torrentBaseSettings.preferMagnetUrl"
+				bool_value = false
+			},
+			{
+				name = "freeleechOnly"
+				bool_value = false
+			},
+			{
+				name = "useFilenames"
+				bool_value = true
+			},
+			{
+				name = "origins"
+				set_value = []
+			},
+		]
+	}`, name, version, version)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -23,12 +24,12 @@ func NewIndexerSchemaDataSource() datasource.DataSource {
 
 // IndexerSchemaDataSource defines the indexer schema implementation.
 type IndexerSchemaDataSource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // IndexerSchema describes the indexer data model.
 type IndexerSchema struct {
+	Instance       types.String `tfsdk:"instance"`
 	IndexerURLs    types.Set    `tfsdk:"indexer_urls"`
 	LegacyURLs     types.Set    `tfsdk:"legacy_urls"`
 	Fields         types.Set    `tfsdk:"fields"`
@@ -59,6 +60,7 @@ func (d *IndexerSchemaDataSource) Schema(_ context.Context, _ datasource.SchemaR
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer schema definition.",
 		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
 			"config_contract": schema.StringAttribute{
 				MarkdownDescription: "Indexer configuration template.",
 				Computed:            true,
@@ -136,10 +138,7 @@ func (d IndexerSchemaDataSource) getFieldSchema() schema.Schema {
 }
 
 func (d *IndexerSchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
-		d.client = client
-		d.auth = auth
-	}
+	d.data = dataSourceConfigure(ctx, req, resp)
 }
 
 func (d *IndexerSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -151,8 +150,15 @@ func (d *IndexerSchemaDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
+	instance, err := d.data.instance(data.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get indexers current value
-	response, _, err := d.client.IndexerAPI.ListIndexerSchema(d.auth).Execute()
+	response, _, err := instance.Client.IndexerAPI.ListIndexerSchema(instance.Auth).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerSchemaDataSourceName, err))
 
@@ -4,10 +4,10 @@ import (
 	"context"
 	"strconv"
 
-	"github.com/devopsarr/prowlarr-go/prowlarr"
 	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -23,12 +23,12 @@ func NewIndexerSchemasDataSource() datasource.DataSource {
 
 // IndexerSchemasDataSource defines the indexers implementation.
 type IndexerSchemasDataSource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // IndexerSchemas describes the indexers data model.
 type IndexerSchemas struct {
+	Instance       types.String `tfsdk:"instance"`
 	IndexerSchemas types.List   `tfsdk:"indexer_schemas"`
 	ID             types.String `tfsdk:"id"`
 }
@@ -42,6 +42,7 @@ func (d *IndexerSchemasDataSource) Schema(_ context.Context, _ datasource.Schema
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nList all available [Indexer Schemas](../data-sources/indexer_schema).",
 		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
 			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -56,15 +57,28 @@ func (d *IndexerSchemasDataSource) Schema(_ context.Context, _ datasource.Schema
 }
 
 func (d *IndexerSchemasDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
-		d.client = client
-		d.auth = auth
-	}
+	d.data = dataSourceConfigure(ctx, req, resp)
 }
 
-func (d *IndexerSchemasDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *IndexerSchemasDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the instance from config
+	var name types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := d.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get indexers current value
-	response, _, err := d.client.IndexerAPI.ListIndexerSchema(d.auth).Execute()
+	response, _, err := instance.Client.IndexerAPI.ListIndexerSchema(instance.Auth).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerSchemasDataSourceName, err))
 
@@ -80,5 +94,5 @@ func (d *IndexerSchemasDataSource) Read(ctx context.Context, _ datasource.ReadRe
 
 	indexerList, diags := types.ListValueFrom(ctx, types.StringType, indexers)
 	resp.Diagnostics.Append(diags...)
-	resp.Diagnostics.Append(resp.State.Set(ctx, IndexerSchemas{IndexerSchemas: indexerList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, IndexerSchemas{Instance: name, IndexerSchemas: indexerList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
 }
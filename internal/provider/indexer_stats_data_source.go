@@ -0,0 +1,306 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const indexerStatsDataSourceName = "indexer_stats"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IndexerStatsDataSource{}
+
+func NewIndexerStatsDataSource() datasource.DataSource {
+	return &IndexerStatsDataSource{}
+}
+
+// IndexerStatsDataSource defines the indexer stats implementation.
+type IndexerStatsDataSource struct {
+	data *ProwlarrData
+}
+
+// IndexerStats describes the indexer stats data model.
+type IndexerStats struct {
+	Instance   types.String `tfsdk:"instance"`
+	Indexers   types.Set    `tfsdk:"indexers"`
+	UserAgents types.Set    `tfsdk:"user_agents"`
+	Hosts      types.Set    `tfsdk:"hosts"`
+	IndexerIDs types.Set    `tfsdk:"indexer_ids"`
+	Tags       types.Set    `tfsdk:"tags"`
+	StartDate  types.String `tfsdk:"start_date"`
+	EndDate    types.String `tfsdk:"end_date"`
+	ID         types.String `tfsdk:"id"`
+}
+
+// IndexerStatistics is part of IndexerStats.
+type IndexerStatistics struct {
+	IndexerName               types.String `tfsdk:"indexer_name"`
+	IndexerID                 types.Int64  `tfsdk:"indexer_id"`
+	AverageResponseTime       types.Int64  `tfsdk:"average_response_time"`
+	AverageGrabResponseTime   types.Int64  `tfsdk:"average_grab_response_time"`
+	NumberOfQueries           types.Int64  `tfsdk:"number_of_queries"`
+	NumberOfGrabs             types.Int64  `tfsdk:"number_of_grabs"`
+	NumberOfRssQueries        types.Int64  `tfsdk:"number_of_rss_queries"`
+	NumberOfAuthQueries       types.Int64  `tfsdk:"number_of_auth_queries"`
+	NumberOfFailedQueries     types.Int64  `tfsdk:"number_of_failed_queries"`
+	NumberOfFailedGrabs       types.Int64  `tfsdk:"number_of_failed_grabs"`
+	NumberOfFailedRssQueries  types.Int64  `tfsdk:"number_of_failed_rss_queries"`
+	NumberOfFailedAuthQueries types.Int64  `tfsdk:"number_of_failed_auth_queries"`
+}
+
+// UserAgentStatistics is part of IndexerStats.
+type UserAgentStatistics struct {
+	UserAgent       types.String `tfsdk:"user_agent"`
+	NumberOfQueries types.Int64  `tfsdk:"number_of_queries"`
+	NumberOfGrabs   types.Int64  `tfsdk:"number_of_grabs"`
+}
+
+// HostStatistics is part of IndexerStats.
+type HostStatistics struct {
+	Host            types.String `tfsdk:"host"`
+	NumberOfQueries types.Int64  `tfsdk:"number_of_queries"`
+	NumberOfGrabs   types.Int64  `tfsdk:"number_of_grabs"`
+}
+
+func (d *IndexerStatsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + indexerStatsDataSourceName
+}
+
+func (d *IndexerStatsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Indexers -->\nUsage statistics of the [Indexers](../resources/indexer), per indexer, user agent and host.\nFor more information refer to [Stats](https://wiki.servarr.com/prowlarr/indexers#stats) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"start_date": schema.StringAttribute{
+				MarkdownDescription: "Start of the date range (RFC3339).",
+				Optional:            true,
+			},
+			"end_date": schema.StringAttribute{
+				MarkdownDescription: "End of the date range (RFC3339).",
+				Optional:            true,
+			},
+			"indexer_ids": schema.SetAttribute{
+				MarkdownDescription: "Filter by indexer IDs.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Filter by indexer tags.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"indexers": schema.SetNestedAttribute{
+				MarkdownDescription: "Statistics per indexer.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"indexer_id": schema.Int64Attribute{
+							MarkdownDescription: "Indexer ID.",
+							Computed:            true,
+						},
+						"indexer_name": schema.StringAttribute{
+							MarkdownDescription: "Indexer name.",
+							Computed:            true,
+						},
+						"average_response_time": schema.Int64Attribute{
+							MarkdownDescription: "Average response time in milliseconds.",
+							Computed:            true,
+						},
+						"average_grab_response_time": schema.Int64Attribute{
+							MarkdownDescription: "Average grab response time in milliseconds.",
+							Computed:            true,
+						},
+						"number_of_queries": schema.Int64Attribute{
+							MarkdownDescription: "Number of queries.",
+							Computed:            true,
+						},
+						"number_of_grabs": schema.Int64Attribute{
+							MarkdownDescription: "Number of grabs.",
+							Computed:            true,
+						},
+						"number_of_rss_queries": schema.Int64Attribute{
+							MarkdownDescription: "Number of RSS queries.",
+							Computed:            true,
+						},
+						"number_of_auth_queries": schema.Int64Attribute{
+							MarkdownDescription: "Number of auth queries.",
+							Computed:            true,
+						},
+						"number_of_failed_queries": schema.Int64Attribute{
+							MarkdownDescription: "Number of failed queries.",
+							Computed:            true,
+						},
+						"number_of_failed_grabs": schema.Int64Attribute{
+							MarkdownDescription: "Number of failed grabs.",
+							Computed:            true,
+						},
+						"number_of_failed_rss_queries": schema.Int64Attribute{
+							MarkdownDescription: "Number of failed RSS queries.",
+							Computed:            true,
+						},
+						"number_of_failed_auth_queries": schema.Int64Attribute{
+							MarkdownDescription: "Number of failed auth queries.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"user_agents": schema.SetNestedAttribute{
+				MarkdownDescription: "Statistics per user agent.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_agent": schema.StringAttribute{
+							MarkdownDescription: "User agent.",
+							Computed:            true,
+						},
+						"number_of_queries": schema.Int64Attribute{
+							MarkdownDescription: "Number of queries.",
+							Computed:            true,
+						},
+						"number_of_grabs": schema.Int64Attribute{
+							MarkdownDescription: "Number of grabs.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"hosts": schema.SetNestedAttribute{
+				MarkdownDescription: "Statistics per host.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"host": schema.StringAttribute{
+							MarkdownDescription: "Host.",
+							Computed:            true,
+						},
+						"number_of_queries": schema.Int64Attribute{
+							MarkdownDescription: "Number of queries.",
+							Computed:            true,
+						},
+						"number_of_grabs": schema.Int64Attribute{
+							MarkdownDescription: "Number of grabs.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IndexerStatsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.data = dataSourceConfigure(ctx, req, resp)
+}
+
+func (d *IndexerStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *IndexerStats
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := d.data.instance(data.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	// Build filters
+	request := instance.Client.IndexerStatsAPI.GetIndexerStats(instance.Auth)
+
+	if startDate, ok := helpers.ParseTimeAttribute(data.StartDate, path.Root("start_date"), &resp.Diagnostics); ok {
+		request = request.StartDate(startDate)
+	}
+
+	if endDate, ok := helpers.ParseTimeAttribute(data.EndDate, path.Root("end_date"), &resp.Diagnostics); ok {
+		request = request.EndDate(endDate)
+	}
+
+	if indexers := helpers.JoinIDs(ctx, data.IndexerIDs, &resp.Diagnostics); indexers != "" {
+		request = request.Indexers(indexers)
+	}
+
+	if tags := helpers.JoinIDs(ctx, data.Tags, &resp.Diagnostics); tags != "" {
+		request = request.Tags(tags)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get indexer stats current value
+	response, _, err := request.Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerStatsDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+indexerStatsDataSourceName)
+	// Map response body to resource schema attribute
+	data.write(ctx, response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (i *IndexerStats) write(ctx context.Context, stats *prowlarr.IndexerStatsResource) {
+	indexers := make([]IndexerStatistics, len(stats.GetIndexers()))
+	for n, s := range stats.GetIndexers() {
+		indexers[n].write(&s)
+	}
+
+	userAgents := make([]UserAgentStatistics, len(stats.GetUserAgents()))
+	for n, s := range stats.GetUserAgents() {
+		userAgents[n] = UserAgentStatistics{
+			UserAgent:       types.StringValue(s.GetUserAgent()),
+			NumberOfQueries: types.Int64Value(int64(s.GetNumberOfQueries())),
+			NumberOfGrabs:   types.Int64Value(int64(s.GetNumberOfGrabs())),
+		}
+	}
+
+	hosts := make([]HostStatistics, len(stats.GetHosts()))
+	for n, s := range stats.GetHosts() {
+		hosts[n] = HostStatistics{
+			Host:            types.StringValue(s.GetHost()),
+			NumberOfQueries: types.Int64Value(int64(s.GetNumberOfQueries())),
+			NumberOfGrabs:   types.Int64Value(int64(s.GetNumberOfGrabs())),
+		}
+	}
+
+	tfsdk.ValueFrom(ctx, indexers, i.Indexers.Type(ctx), &i.Indexers)
+	tfsdk.ValueFrom(ctx, userAgents, i.UserAgents.Type(ctx), &i.UserAgents)
+	tfsdk.ValueFrom(ctx, hosts, i.Hosts.Type(ctx), &i.Hosts)
+	// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+	i.ID = types.StringValue(indexerStatsDataSourceName)
+}
+
+func (s *IndexerStatistics) write(stats *prowlarr.IndexerStatistics) {
+	s.IndexerID = types.Int64Value(int64(stats.GetIndexerId()))
+	s.IndexerName = types.StringValue(stats.GetIndexerName())
+	s.AverageResponseTime = types.Int64Value(int64(stats.GetAverageResponseTime()))
+	s.AverageGrabResponseTime = types.Int64Value(int64(stats.GetAverageGrabResponseTime()))
+	s.NumberOfQueries = types.Int64Value(int64(stats.GetNumberOfQueries()))
+	s.NumberOfGrabs = types.Int64Value(int64(stats.GetNumberOfGrabs()))
+	s.NumberOfRssQueries = types.Int64Value(int64(stats.GetNumberOfRssQueries()))
+	s.NumberOfAuthQueries = types.Int64Value(int64(stats.GetNumberOfAuthQueries()))
+	s.NumberOfFailedQueries = types.Int64Value(int64(stats.GetNumberOfFailedQueries()))
+	s.NumberOfFailedGrabs = types.Int64Value(int64(stats.GetNumberOfFailedGrabs()))
+	s.NumberOfFailedRssQueries = types.Int64Value(int64(stats.GetNumberOfFailedRssQueries()))
+	s.NumberOfFailedAuthQueries = types.Int64Value(int64(stats.GetNumberOfFailedAuthQueries()))
+}
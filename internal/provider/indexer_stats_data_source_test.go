@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIndexerStatsDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccIndexerStatsDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Invalid date
+			{
+				Config:      testAccIndexerStatsDataSourceInvalidConfig,
+				ExpectError: regexp.MustCompile("RFC3339"),
+			},
+			// Read testing
+			{
+				Config: testAccIndexerStatsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prowlarr_indexer_stats.test", "id", "indexer_stats"),
+				),
+			},
+		},
+	})
+}
+
+const testAccIndexerStatsDataSourceConfig = `
+data "prowlarr_indexer_stats" "test" {
+	start_date = "2020-01-01T00:00:00Z"
+}
+`
+
+const testAccIndexerStatsDataSourceInvalidConfig = `
+data "prowlarr_indexer_stats" "test" {
+	start_date = "2020-01-01"
+}
+`
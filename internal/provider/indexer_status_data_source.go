@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const indexerStatusDataSourceName = "indexer_status"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IndexerStatusDataSource{}
+
+func NewIndexerStatusDataSource() datasource.DataSource {
+	return &IndexerStatusDataSource{}
+}
+
+// IndexerStatusDataSource defines the indexer status implementation.
+type IndexerStatusDataSource struct {
+	data *ProwlarrData
+}
+
+// IndexerStatuses describes the indexer status data model.
+type IndexerStatuses struct {
+	Instance   types.String `tfsdk:"instance"`
+	Statuses   types.Set    `tfsdk:"statuses"`
+	IndexerIDs types.Set    `tfsdk:"indexer_ids"`
+	ID         types.String `tfsdk:"id"`
+}
+
+// IndexerStatus is part of IndexerStatuses.
+type IndexerStatus struct {
+	DisabledTill      types.String `tfsdk:"disabled_till"`
+	MostRecentFailure types.String `tfsdk:"most_recent_failure"`
+	InitialFailure    types.String `tfsdk:"initial_failure"`
+	ID                types.Int64  `tfsdk:"id"`
+	IndexerID         types.Int64  `tfsdk:"indexer_id"`
+	Disabled          types.Bool   `tfsdk:"disabled"`
+}
+
+func (d *IndexerStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + indexerStatusDataSourceName
+}
+
+func (d *IndexerStatusDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Indexers -->\nList the status of the [Indexers](../resources/indexer) with failures.\nFor more information refer to [Indexer](https://wiki.servarr.com/prowlarr/indexers) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"indexer_ids": schema.SetAttribute{
+				MarkdownDescription: "Filter by indexer IDs. If empty, all the indexers are returned.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"statuses": schema.SetNestedAttribute{
+				MarkdownDescription: "Indexer status list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Status ID.",
+							Computed:            true,
+						},
+						"indexer_id": schema.Int64Attribute{
+							MarkdownDescription: "Indexer ID.",
+							Computed:            true,
+						},
+						"disabled": schema.BoolAttribute{
+							MarkdownDescription: "Disabled flag. True if the indexer is currently disabled due to failures.",
+							Computed:            true,
+						},
+						"disabled_till": schema.StringAttribute{
+							MarkdownDescription: "Disabled till timestamp (RFC3339).",
+							Computed:            true,
+						},
+						"most_recent_failure": schema.StringAttribute{
+							MarkdownDescription: "Most recent failure timestamp (RFC3339).",
+							Computed:            true,
+						},
+						"initial_failure": schema.StringAttribute{
+							MarkdownDescription: "Initial failure timestamp (RFC3339).",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IndexerStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.data = dataSourceConfigure(ctx, req, resp)
+}
+
+func (d *IndexerStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *IndexerStatuses
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := d.data.instance(data.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	// Get indexer status current value
+	response, _, err := instance.Client.IndexerStatusAPI.ListIndexerStatus(instance.Auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerStatusDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+indexerStatusDataSourceName)
+
+	indexerIDs := make([]int64, len(data.IndexerIDs.Elements()))
+	resp.Diagnostics.Append(data.IndexerIDs.ElementsAs(ctx, &indexerIDs, true)...)
+
+	// Map response body to resource schema attribute
+	statuses := make([]IndexerStatus, 0, len(response))
+
+	for _, s := range response {
+		if len(indexerIDs) > 0 && !slices.Contains(indexerIDs, int64(s.GetIndexerId())) {
+			continue
+		}
+
+		var status IndexerStatus
+
+		status.write(&s)
+		statuses = append(statuses, status)
+	}
+
+	tfsdk.ValueFrom(ctx, statuses, data.Statuses.Type(ctx), &data.Statuses)
+	// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+	data.ID = types.StringValue(strconv.Itoa(len(statuses)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (s *IndexerStatus) write(status *prowlarr.IndexerStatusResource) {
+	disabledTill, _ := status.GetDisabledTillOk()
+	mostRecentFailure, _ := status.GetMostRecentFailureOk()
+	initialFailure, _ := status.GetInitialFailureOk()
+
+	s.ID = types.Int64Value(int64(status.GetId()))
+	s.IndexerID = types.Int64Value(int64(status.GetIndexerId()))
+	s.Disabled = types.BoolValue(disabledTill != nil && disabledTill.After(time.Now()))
+	s.DisabledTill = helpers.TimeValue(disabledTill)
+	s.MostRecentFailure = helpers.TimeValue(mostRecentFailure)
+	s.InitialFailure = helpers.TimeValue(initialFailure)
+}
@@ -4,10 +4,10 @@ import (
 	"context"
 	"strconv"
 
-	"github.com/devopsarr/prowlarr-go/prowlarr"
 	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -24,12 +24,12 @@ func NewIndexersDataSource() datasource.DataSource {
 
 // IndexersDataSource defines the indexers implementation.
 type IndexersDataSource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // Indexers describes the indexers data model.
 type Indexers struct {
+	Instance types.String `tfsdk:"instance"`
 	Indexers types.Set    `tfsdk:"indexers"`
 	ID       types.String `tfsdk:"id"`
 }
@@ -43,6 +43,7 @@ func (d *IndexersDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nList all available [Indexers](../resources/indexer).",
 		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
 			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -143,10 +144,7 @@ func (d *IndexersDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 }
 
 func (d *IndexersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
-		d.client = client
-		d.auth = auth
-	}
+	d.data = dataSourceConfigure(ctx, req, resp)
 }
 
 func (d *IndexersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -158,8 +156,15 @@ func (d *IndexersDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	instance, err := d.data.instance(data.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get indexers current value
-	response, _, err := d.client.IndexerAPI.ListIndexer(d.auth).Execute()
+	response, _, err := instance.Client.IndexerAPI.ListIndexer(instance.Auth).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexersDataSourceName, err))
 
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+const (
+	instanceAttribute   = "instance"
+	instanceDescription = "Name of the provider `instances` entry to use. If unset, the default provider `url` and `api_key` are used."
+	instanceImportSep   = ":"
+)
+
+var errUnknownInstance = errors.New("unknown instance")
+
+// instance returns the data of the named instance, the default one if name is empty.
+func (d *ProwlarrData) instance(name string) (*ProwlarrData, error) {
+	if d == nil {
+		return nil, fmt.Errorf("%w: provider not configured", errUnknownInstance)
+	}
+
+	if name == "" {
+		if d.Client == nil {
+			return nil, fmt.Errorf("%w: no default instance, set the provider url or the instance attribute", errUnknownInstance)
+		}
+
+		return d, nil
+	}
+
+	instance, ok := d.Instances[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s' is not defined in the provider instances", errUnknownInstance, name)
+	}
+
+	return instance, nil
+}
+
+// resourceInstanceSchema returns the instance attribute of resources.
+func resourceInstanceSchema() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: instanceDescription,
+		Optional:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// dataSourceInstanceSchema returns the instance attribute of data sources.
+func dataSourceInstanceSchema() datasourceschema.StringAttribute {
+	return datasourceschema.StringAttribute{
+		MarkdownDescription: instanceDescription,
+		Optional:            true,
+	}
+}
+
+// importInstance sets the instance of an `<instance>:<id>` import identifier and returns the identifier without it.
+// Identifiers without a defined instance prefix are returned as they are.
+func importInstance(ctx context.Context, data *ProwlarrData, id string, resp *resource.ImportStateResponse) string {
+	name, remainder, found := strings.Cut(id, instanceImportSep)
+	if !found || data == nil || data.Instances[name] == nil {
+		return id
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(instanceAttribute), name)...)
+
+	return remainder
+}
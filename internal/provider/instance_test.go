@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccInstanceResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unknown instance
+			{
+				Config:      testAccInstanceResourceConfig("missing", "instance"),
+				ExpectError: regexp.MustCompile("Unknown Prowlarr Instance"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccInstanceResourceConfig("second", "instance"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_tag.test", "instance", "second"),
+					resource.TestCheckResourceAttr("prowlarr_tag.test", "label", "instance"),
+					resource.TestCheckResourceAttrSet("prowlarr_tag.test", "id"),
+					resource.TestCheckResourceAttr("data.prowlarr_tag.test", "instance", "second"),
+					resource.TestCheckResourceAttrPair("data.prowlarr_tag.test", "id", "prowlarr_tag.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "prowlarr_tag.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return "second:" + s.RootModule().Resources["prowlarr_tag.test"].Primary.ID, nil
+				},
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccInstanceResourceConfig(instance, label string) string {
+	return fmt.Sprintf(`
+		provider "prowlarr" {
+			instances = {
+				second = {
+					url     = "%s"
+					api_key = "%s"
+				}
+			}
+		}
+
+		resource "prowlarr_tag" "test" {
+			instance = "%s"
+			label    = "%s"
+		}
+
+		data "prowlarr_tag" "test" {
+			instance = prowlarr_tag.test.instance
+			label    = prowlarr_tag.test.label
+		}
+	`, os.Getenv("PROWLARR_URL"), os.Getenv("PROWLARR_API_KEY"), instance, label)
+}
+
+func TestProwlarrDataInstance(t *testing.T) {
+	t.Parallel()
+
+	second := &ProwlarrData{Client: prowlarr.NewAPIClient(prowlarr.NewConfiguration())}
+	data := &ProwlarrData{
+		Client:    prowlarr.NewAPIClient(prowlarr.NewConfiguration()),
+		Instances: map[string]*ProwlarrData{"second": second},
+	}
+
+	tests := map[string]struct {
+		data     *ProwlarrData
+		expected *ProwlarrData
+		name     string
+	}{
+		"default": {
+			data:     data,
+			expected: data,
+		},
+		"named": {
+			data:     data,
+			name:     "second",
+			expected: second,
+		},
+		"missing": {
+			data: data,
+			name: "missing",
+		},
+		"no default": {
+			data: &ProwlarrData{Instances: data.Instances},
+		},
+		"not configured": {},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			instance, err := test.data.instance(test.name)
+			if test.expected == nil {
+				assert.ErrorIs(t, err, errUnknownInstance)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Same(t, test.expected, instance)
+		})
+	}
+}
+
+func TestImportInstance(t *testing.T) {
+	t.Parallel()
+
+	data := &ProwlarrData{Instances: map[string]*ProwlarrData{"second": {}}}
+
+	tests := map[string]struct {
+		id       string
+		expected string
+		instance types.String
+	}{
+		"default": {
+			id:       "1",
+			expected: "1",
+			instance: types.StringNull(),
+		},
+		"named": {
+			id:       "second:1",
+			expected: "1",
+			instance: types.StringValue("second"),
+		},
+		"undefined": {
+			id:       "missing:1",
+			expected: "missing:1",
+			instance: types.StringNull(),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			schemaResp := fwresource.SchemaResponse{}
+			NewTagResource().Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+			resp := fwresource.ImportStateResponse{State: tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}}
+
+			assert.Equal(t, test.expected, importInstance(ctx, data, test.id, &resp))
+			assert.False(t, resp.Diagnostics.HasError())
+
+			var instance types.String
+
+			resp.State.GetAttribute(ctx, path.Root(instanceAttribute), &instance)
+			assert.Equal(t, test.instance, instance)
+		})
+	}
+}
@@ -22,12 +22,14 @@ const (
 	notificationAppriseResourceName   = "notification_apprise"
 	notificationAppriseImplementation = "Apprise"
 	notificationAppriseConfigContract = "AppriseSettings"
+	notificationAppriseMinVersion     = "1.1.0"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &NotificationAppriseResource{}
 	_ resource.ResourceWithImportState = &NotificationAppriseResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationAppriseResource{}
 )
 
 func NewNotificationAppriseResource() resource.Resource {
@@ -36,12 +38,12 @@ func NewNotificationAppriseResource() resource.Resource {
 
 // NotificationAppriseResource defines the notification implementation.
 type NotificationAppriseResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // NotificationApprise describes the notification data model.
 type NotificationApprise struct {
+	Instance              types.String `tfsdk:"instance"`
 	Tags                  types.Set    `tfsdk:"tags"`
 	FieldTags             types.Set    `tfsdk:"field_tags"`
 	ConfigurationKey      types.String `tfsdk:"configuration_key"`
@@ -58,6 +60,7 @@ type NotificationApprise struct {
 	IncludeManualGrabs    types.Bool   `tfsdk:"include_manual_grabs"`
 	OnHealthIssue         types.Bool   `tfsdk:"on_health_issue"`
 	OnHealthRestored      types.Bool   `tfsdk:"on_health_restored"`
+	TestOnApply           types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (n NotificationApprise) toNotification() *Notification {
@@ -108,8 +111,13 @@ func (r *NotificationAppriseResource) Metadata(_ context.Context, req resource.M
 
 func (r *NotificationAppriseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Apprise resource.\nFor more information refer to [Notification](https://wiki.servarr.com/prowlarr/settings#connect) and [Apprise](https://wiki.servarr.com/prowlarr/supported#apprise).",
+		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Apprise resource. Requires Prowlarr >= " + notificationAppriseMinVersion + ".\nFor more information refer to [Notification](https://wiki.servarr.com/prowlarr/settings#connect) and [Apprise](https://wiki.servarr.com/prowlarr/supported#apprise).",
 		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Test the configuration against Prowlarr before applying it.",
+				Optional:            true,
+			},
 			"on_health_issue": schema.BoolAttribute{
 				MarkdownDescription: "On health issue flag.",
 				Optional:            true,
@@ -204,10 +212,31 @@ func (r *NotificationAppriseResource) Schema(_ context.Context, _ resource.Schem
 }
 
 func (r *NotificationAppriseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	r.data = resourceConfigure(ctx, req, resp)
+}
+
+func (r *NotificationAppriseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy or if the provider is not configured yet.
+	if req.Plan.Raw.IsNull() || r.data == nil {
+		return
 	}
+
+	var name types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
+
+	if resp.Diagnostics.HasError() || name.IsUnknown() {
+		return
+	}
+
+	instance, err := r.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	checkVersion(instance, path.Root("name"), notificationAppriseResourceName, notificationAppriseMinVersion, "", &resp.Diagnostics)
 }
 
 func (r *NotificationAppriseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -220,10 +249,27 @@ func (r *NotificationAppriseResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	instance, err := r.data.instance(notification.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Create new NotificationApprise
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	if notification.TestOnApply.ValueBool() {
+		if _, err = instance.Client.NotificationAPI.TestNotification(instance.Auth).NotificationResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, notificationAppriseResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.NotificationAPI.CreateNotification(instance.Auth).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationAppriseResourceName, err))
 
@@ -246,10 +292,17 @@ func (r *NotificationAppriseResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	instance, err := r.data.instance(notification.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get NotificationApprise current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := instance.Client.NotificationAPI.GetNotificationById(instance.Auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationAppriseResourceName, err))
+		helpers.HandleReadError(ctx, notificationAppriseResourceName, err, httpResp, resp)
 
 		return
 	}
@@ -270,10 +323,27 @@ func (r *NotificationAppriseResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	instance, err := r.data.instance(notification.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Update NotificationApprise
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
+	if notification.TestOnApply.ValueBool() {
+		if _, err = instance.Client.NotificationAPI.TestNotification(instance.Auth).NotificationResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, notificationAppriseResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.NotificationAPI.UpdateNotification(instance.Auth, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationAppriseResourceName, err))
 
@@ -287,16 +357,27 @@ func (r *NotificationAppriseResource) Update(ctx context.Context, req resource.U
 }
 
 func (r *NotificationAppriseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID   int64
+		name types.String
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	instance, err := r.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Delete NotificationApprise current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err = instance.Client.NotificationAPI.DeleteNotification(instance.Auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationAppriseResourceName, err))
 
@@ -308,6 +389,7 @@ func (r *NotificationAppriseResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *NotificationAppriseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importInstance(ctx, r.data, req.ID, resp)
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
 	tflog.Trace(ctx, "imported "+notificationAppriseResourceName+": "+req.ID)
 }
@@ -35,12 +35,12 @@ func NewNotificationCustomScriptResource() resource.Resource {
 
 // NotificationCustomScriptResource defines the notification implementation.
 type NotificationCustomScriptResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // NotificationCustomScript describes the notification data model.
 type NotificationCustomScript struct {
+	Instance              types.String `tfsdk:"instance"`
 	Tags                  types.Set    `tfsdk:"tags"`
 	Arguments             types.String `tfsdk:"arguments"`
 	Path                  types.String `tfsdk:"path"`
@@ -50,6 +50,7 @@ type NotificationCustomScript struct {
 	OnHealthRestored      types.Bool   `tfsdk:"on_health_restored"`
 	OnApplicationUpdate   types.Bool   `tfsdk:"on_application_update"`
 	IncludeHealthWarnings types.Bool   `tfsdk:"include_health_warnings"`
+	TestOnApply           types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (n NotificationCustomScript) toNotification() *Notification {
@@ -88,6 +89,11 @@ func (r *NotificationCustomScriptResource) Schema(_ context.Context, _ resource.
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Custom Script resource.\nFor more information refer to [Notification](https://wiki.servarr.com/prowlarr/settings#connect) and [Custom Script](https://wiki.servarr.com/prowlarr/supported#customscript).",
 		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Test the configuration against Prowlarr before applying it.",
+				Optional:            true,
+			},
 			"on_health_issue": schema.BoolAttribute{
 				MarkdownDescription: "On health issue flag.",
 				Optional:            true,
@@ -139,10 +145,7 @@ func (r *NotificationCustomScriptResource) Schema(_ context.Context, _ resource.
 }
 
 func (r *NotificationCustomScriptResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
-	}
+	r.data = resourceConfigure(ctx, req, resp)
 }
 
 func (r *NotificationCustomScriptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -155,10 +158,27 @@ func (r *NotificationCustomScriptResource) Create(ctx context.Context, req resou
 		return
 	}
 
+	instance, err := r.data.instance(notification.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Create new NotificationCustomScript
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	if notification.TestOnApply.ValueBool() {
+		if _, err = instance.Client.NotificationAPI.TestNotification(instance.Auth).NotificationResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, notificationCustomScriptResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.NotificationAPI.CreateNotification(instance.Auth).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationCustomScriptResourceName, err))
 
@@ -181,10 +201,17 @@ func (r *NotificationCustomScriptResource) Read(ctx context.Context, req resourc
 		return
 	}
 
+	instance, err := r.data.instance(notification.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get NotificationCustomScript current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := instance.Client.NotificationAPI.GetNotificationById(instance.Auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationCustomScriptResourceName, err))
+		helpers.HandleReadError(ctx, notificationCustomScriptResourceName, err, httpResp, resp)
 
 		return
 	}
@@ -205,10 +232,27 @@ func (r *NotificationCustomScriptResource) Update(ctx context.Context, req resou
 		return
 	}
 
+	instance, err := r.data.instance(notification.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Update NotificationCustomScript
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
+	if notification.TestOnApply.ValueBool() {
+		if _, err = instance.Client.NotificationAPI.TestNotification(instance.Auth).NotificationResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, notificationCustomScriptResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.NotificationAPI.UpdateNotification(instance.Auth, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationCustomScriptResourceName, err))
 
@@ -222,16 +266,27 @@ func (r *NotificationCustomScriptResource) Update(ctx context.Context, req resou
 }
 
 func (r *NotificationCustomScriptResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID   int64
+		name types.String
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	instance, err := r.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Delete NotificationCustomScript current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err = instance.Client.NotificationAPI.DeleteNotification(instance.Auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationCustomScriptResourceName, err))
 
@@ -243,6 +298,7 @@ func (r *NotificationCustomScriptResource) Delete(ctx context.Context, req resou
 }
 
 func (r *NotificationCustomScriptResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importInstance(ctx, r.data, req.ID, resp)
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
 	tflog.Trace(ctx, "imported "+notificationCustomScriptResourceName+": "+req.ID)
 }
@@ -5,10 +5,10 @@ import (
 
 	"github.com/devopsarr/prowlarr-go/prowlarr"
 	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
-
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +24,13 @@ func NewNotificationDataSource() datasource.DataSource {
 
 // NotificationDataSource defines the notification implementation.
 type NotificationDataSource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
+}
+
+// InstanceNotification extends Notification with the instance attribute, which the list elements do not have.
+type InstanceNotification struct {
+	Instance types.String `tfsdk:"instance"`
+	Notification
 }
 
 func (d *NotificationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -37,6 +42,7 @@ func (d *NotificationDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 		// This description is used by the documentation generator and the delay server.
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nSingle [Notification](../resources/notification).",
 		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
 			"on_health_issue": schema.BoolAttribute{
 				MarkdownDescription: "On health issue flag.",
 				Computed:            true,
@@ -397,22 +403,26 @@ func (d *NotificationDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 }
 
 func (d *NotificationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
-		d.client = client
-		d.auth = auth
-	}
+	d.data = dataSourceConfigure(ctx, req, resp)
 }
 
 func (d *NotificationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data *Notification
+	var data *InstanceNotification
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	instance, err := d.data.instance(data.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
 	// Get notification current value
-	response, _, err := d.client.NotificationAPI.ListNotification(d.auth).Execute()
+	response, _, err := instance.Client.NotificationAPI.ListNotification(instance.Auth).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationDataSourceName, err))
 
@@ -34,12 +34,12 @@ func NewNotificationDiscordResource() resource.Resource {
 
 // NotificationDiscordResource defines the notification implementation.
 type NotificationDiscordResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // NotificationDiscord describes the notification data model.
 type NotificationDiscord struct {
+	Instance              types.String `tfsdk:"instance"`
 	Tags                  types.Set    `tfsdk:"tags"`
 	GrabFields            types.Set    `tfsdk:"grab_fields"`
 	WebHookURL            types.String `tfsdk:"web_hook_url"`
@@ -54,6 +54,7 @@ type NotificationDiscord struct {
 	IncludeManualGrabs    types.Bool   `tfsdk:"include_manual_grabs"`
 	OnHealthIssue         types.Bool   `tfsdk:"on_health_issue"`
 	OnHealthRestored      types.Bool   `tfsdk:"on_health_restored"`
+	TestOnApply           types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (n NotificationDiscord) toNotification() *Notification {
@@ -102,6 +103,11 @@ func (r *NotificationDiscordResource) Schema(_ context.Context, _ resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Discord resource.\nFor more information refer to [Notification](https://wiki.servarr.com/prowlarr/settings#connect) and [Discord](https://wiki.servarr.com/prowlarr/supported#discord).",
 		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Test the configuration against Prowlarr before applying it.",
+				Optional:            true,
+			},
 			"on_health_issue": schema.BoolAttribute{
 				MarkdownDescription: "On health issue flag.",
 				Optional:            true,
@@ -180,10 +186,7 @@ func (r *NotificationDiscordResource) Schema(_ context.Context, _ resource.Schem
 }
 
 func (r *NotificationDiscordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
-	}
+	r.data = resourceConfigure(ctx, req, resp)
 }
 
 func (r *NotificationDiscordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -196,10 +199,27 @@ func (r *NotificationDiscordResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	instance, err := r.data.instance(notification.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Create new NotificationDiscord
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	if notification.TestOnApply.ValueBool() {
+		if _, err = instance.Client.NotificationAPI.TestNotification(instance.Auth).NotificationResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, notificationDiscordResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.NotificationAPI.CreateNotification(instance.Auth).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationDiscordResourceName, err))
 
@@ -222,10 +242,17 @@ func (r *NotificationDiscordResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	instance, err := r.data.instance(notification.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get NotificationDiscord current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := instance.Client.NotificationAPI.GetNotificationById(instance.Auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationDiscordResourceName, err))
+		helpers.HandleReadError(ctx, notificationDiscordResourceName, err, httpResp, resp)
 
 		return
 	}
@@ -246,10 +273,27 @@ func (r *NotificationDiscordResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	instance, err := r.data.instance(notification.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Update NotificationDiscord
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
+	if notification.TestOnApply.ValueBool() {
+		if _, err = instance.Client.NotificationAPI.TestNotification(instance.Auth).NotificationResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, notificationDiscordResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.NotificationAPI.UpdateNotification(instance.Auth, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationDiscordResourceName, err))
 
@@ -263,16 +307,27 @@ func (r *NotificationDiscordResource) Update(ctx context.Context, req resource.U
 }
 
 func (r *NotificationDiscordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID   int64
+		name types.String
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	instance, err := r.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Delete NotificationDiscord current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err = instance.Client.NotificationAPI.DeleteNotification(instance.Auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationDiscordResourceName, err))
 
@@ -284,6 +339,7 @@ func (r *NotificationDiscordResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *NotificationDiscordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importInstance(ctx, r.data, req.ID, resp)
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
 	tflog.Trace(ctx, "imported "+notificationDiscordResourceName+": "+req.ID)
 }
@@ -36,12 +36,12 @@ func NewNotificationEmailResource() resource.Resource {
 
 // NotificationEmailResource defines the notification implementation.
 type NotificationEmailResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // NotificationEmail describes the notification data model.
 type NotificationEmail struct {
+	Instance              types.String `tfsdk:"instance"`
 	Tags                  types.Set    `tfsdk:"tags"`
 	To                    types.Set    `tfsdk:"to"`
 	Cc                    types.Set    `tfsdk:"cc"`
@@ -60,6 +60,7 @@ type NotificationEmail struct {
 	IncludeManualGrabs    types.Bool   `tfsdk:"include_manual_grabs"`
 	OnHealthIssue         types.Bool   `tfsdk:"on_health_issue"`
 	OnHealthRestored      types.Bool   `tfsdk:"on_health_restored"`
+	TestOnApply           types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (n NotificationEmail) toNotification() *Notification {
@@ -116,6 +117,11 @@ func (r *NotificationEmailResource) Schema(_ context.Context, _ resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Email resource.\nFor more information refer to [Notification](https://wiki.servarr.com/prowlarr/settings#connect) and [Email](https://wiki.servarr.com/prowlarr/supported#email).",
 		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Test the configuration against Prowlarr before applying it.",
+				Optional:            true,
+			},
 			"on_health_issue": schema.BoolAttribute{
 				MarkdownDescription: "On health issue flag.",
 				Optional:            true,
@@ -218,10 +224,7 @@ func (r *NotificationEmailResource) Schema(_ context.Context, _ resource.SchemaR
 }
 
 func (r *NotificationEmailResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
-	}
+	r.data = resourceConfigure(ctx, req, resp)
 }
 
 func (r *NotificationEmailResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -234,10 +237,27 @@ func (r *NotificationEmailResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	instance, err := r.data.instance(notification.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Create new NotificationEmail
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	if notification.TestOnApply.ValueBool() {
+		if _, err = instance.Client.NotificationAPI.TestNotification(instance.Auth).NotificationResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, notificationEmailResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.NotificationAPI.CreateNotification(instance.Auth).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationEmailResourceName, err))
 
@@ -260,10 +280,17 @@ func (r *NotificationEmailResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	instance, err := r.data.instance(notification.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get NotificationEmail current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := instance.Client.NotificationAPI.GetNotificationById(instance.Auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationEmailResourceName, err))
+		helpers.HandleReadError(ctx, notificationEmailResourceName, err, httpResp, resp)
 
 		return
 	}
@@ -284,10 +311,27 @@ func (r *NotificationEmailResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	instance, err := r.data.instance(notification.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Update NotificationEmail
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
+	if notification.TestOnApply.ValueBool() {
+		if _, err = instance.Client.NotificationAPI.TestNotification(instance.Auth).NotificationResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, notificationEmailResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.NotificationAPI.UpdateNotification(instance.Auth, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationEmailResourceName, err))
 
@@ -301,16 +345,27 @@ func (r *NotificationEmailResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *NotificationEmailResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID   int64
+		name types.String
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	instance, err := r.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Delete NotificationEmail current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err = instance.Client.NotificationAPI.DeleteNotification(instance.Auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationEmailResourceName, err))
 
@@ -322,6 +377,7 @@ func (r *NotificationEmailResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *NotificationEmailResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importInstance(ctx, r.data, req.ID, resp)
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
 	tflog.Trace(ctx, "imported "+notificationEmailResourceName+": "+req.ID)
 }
@@ -36,12 +36,12 @@ func NewNotificationGotifyResource() resource.Resource {
 
 // NotificationGotifyResource defines the notification implementation.
 type NotificationGotifyResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // NotificationGotify describes the notification data model.
 type NotificationGotify struct {
+	Instance              types.String `tfsdk:"instance"`
 	Tags                  types.Set    `tfsdk:"tags"`
 	Server                types.String `tfsdk:"server"`
 	Name                  types.String `tfsdk:"name"`
@@ -54,6 +54,7 @@ type NotificationGotify struct {
 	IncludeManualGrabs    types.Bool   `tfsdk:"include_manual_grabs"`
 	OnHealthIssue         types.Bool   `tfsdk:"on_health_issue"`
 	OnHealthRestored      types.Bool   `tfsdk:"on_health_restored"`
+	TestOnApply           types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (n NotificationGotify) toNotification() *Notification {
@@ -98,6 +99,11 @@ func (r *NotificationGotifyResource) Schema(_ context.Context, _ resource.Schema
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Gotify resource.\nFor more information refer to [Notification](https://wiki.servarr.com/prowlarr/settings#connect) and [Gotify](https://wiki.servarr.com/prowlarr/supported#gotify).",
 		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Test the configuration against Prowlarr before applying it.",
+				Optional:            true,
+			},
 			"on_health_issue": schema.BoolAttribute{
 				MarkdownDescription: "On health issue flag.",
 				Optional:            true,
@@ -168,10 +174,7 @@ func (r *NotificationGotifyResource) Schema(_ context.Context, _ resource.Schema
 }
 
 func (r *NotificationGotifyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
-	}
+	r.data = resourceConfigure(ctx, req, resp)
 }
 
 func (r *NotificationGotifyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -184,10 +187,27 @@ func (r *NotificationGotifyResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	instance, err := r.data.instance(notification.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Create new NotificationGotify
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	if notification.TestOnApply.ValueBool() {
+		if _, err = instance.Client.NotificationAPI.TestNotification(instance.Auth).NotificationResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, notificationGotifyResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.NotificationAPI.CreateNotification(instance.Auth).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationGotifyResourceName, err))
 
@@ -210,10 +230,17 @@ func (r *NotificationGotifyResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	instance, err := r.data.instance(notification.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get NotificationGotify current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := instance.Client.NotificationAPI.GetNotificationById(instance.Auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationGotifyResourceName, err))
+		helpers.HandleReadError(ctx, notificationGotifyResourceName, err, httpResp, resp)
 
 		return
 	}
@@ -234,10 +261,27 @@ func (r *NotificationGotifyResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	instance, err := r.data.instance(notification.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Update NotificationGotify
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
+	if notification.TestOnApply.ValueBool() {
+		if _, err = instance.Client.NotificationAPI.TestNotification(instance.Auth).NotificationResource(*request).Execute(); err != nil {
+			helpers.HandleTestError(ctx, notificationGotifyResourceName, err, req.Plan, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response, _, err := instance.Client.NotificationAPI.UpdateNotification(instance.Auth, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationGotifyResourceName, err))
 
@@ -251,16 +295,27 @@ func (r *NotificationGotifyResource) Update(ctx context.Context, req resource.Up
 }
 
 func (r *NotificationGotifyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID   int64
+		name types.String
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	instance, err := r.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Delete NotificationGotify current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err = instance.Client.NotificationAPI.DeleteNotification(instance.Auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationGotifyResourceName, err))
 
@@ -272,6 +327,7 @@ func (r *NotificationGotifyResource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *NotificationGotifyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importInstance(ctx, r.data, req.ID, resp)
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
 	tflog.Trace(ctx, "imported "+notificationGotifyResourceName+": "+req.ID)
 }
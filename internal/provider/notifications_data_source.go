@@ -4,11 +4,10 @@ import (
 	"context"
 	"strconv"
 
-	"github.com/devopsarr/prowlarr-go/prowlarr"
 	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
-
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,12 +23,12 @@ func NewNotificationsDataSource() datasource.DataSource {
 
 // NotificationsDataSource defines the notifications implementation.
 type NotificationsDataSource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // Notifications describes the notifications data model.
 type Notifications struct {
+	Instance      types.String `tfsdk:"instance"`
 	Notifications types.Set    `tfsdk:"notifications"`
 	ID            types.String `tfsdk:"id"`
 }
@@ -43,6 +42,7 @@ func (d *NotificationsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 		// This description is used by the documentation generator and the delay server.
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nList all available [Notifications](../resources/notification).",
 		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
 			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -415,15 +415,28 @@ func (d *NotificationsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 }
 
 func (d *NotificationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
-		d.client = client
-		d.auth = auth
-	}
+	d.data = dataSourceConfigure(ctx, req, resp)
 }
 
-func (d *NotificationsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *NotificationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the instance from config
+	var name types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := d.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get notifications current value
-	response, _, err := d.client.NotificationAPI.ListNotification(d.auth).Execute()
+	response, _, err := instance.Client.NotificationAPI.ListNotification(instance.Auth).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationsDataSourceName, err))
 
@@ -439,5 +452,5 @@ func (d *NotificationsDataSource) Read(ctx context.Context, _ datasource.ReadReq
 
 	notificationList, diags := types.SetValueFrom(ctx, Notification{}.getType(), notifications)
 	resp.Diagnostics.Append(diags...)
-	resp.Diagnostics.Append(resp.State.Set(ctx, Notifications{Notifications: notificationList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, Notifications{Instance: name, Notifications: notificationList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
 }
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const parseIndexerURLFunctionName = "parse_indexer_url"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ParseIndexerURLFunction{}
+
+func NewParseIndexerURLFunction() function.Function {
+	return &ParseIndexerURLFunction{}
+}
+
+// ParseIndexerURLFunction defines the indexer URL parser implementation.
+type ParseIndexerURLFunction struct{}
+
+// IndexerURL describes the indexer URL data model.
+type IndexerURL struct {
+	ProwlarrURL types.String `tfsdk:"prowlarr_url"`
+	APIPath     types.String `tfsdk:"api_path"`
+	IndexerID   types.Int64  `tfsdk:"indexer_id"`
+}
+
+func (u IndexerURL) getAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"prowlarr_url": types.StringType,
+		"api_path":     types.StringType,
+		"indexer_id":   types.Int64Type,
+	}
+}
+
+func (f *ParseIndexerURLFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = parseIndexerURLFunctionName
+}
+
+func (f *ParseIndexerURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse an indexer proxy URL",
+		MarkdownDescription: "Split a Prowlarr indexer proxy URL (e.g. `http://localhost:9696/1/api`) into `prowlarr_url`, `indexer_id` and `api_path`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "url",
+				MarkdownDescription: "Indexer proxy URL.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: IndexerURL{}.getAttributeTypes(),
+		},
+	}
+}
+
+func (f *ParseIndexerURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var rawURL string
+
+	resp.Error = req.Arguments.Get(ctx, &rawURL)
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := helpers.ParseIndexerURL(rawURL)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+
+		return
+	}
+
+	var indexerURL IndexerURL
+
+	indexerURL.write(parsed)
+	resp.Error = resp.Result.Set(ctx, indexerURL)
+}
+
+func (u *IndexerURL) write(indexerURL *helpers.IndexerURL) {
+	u.ProwlarrURL = types.StringValue(indexerURL.ProwlarrURL)
+	u.APIPath = types.StringValue(indexerURL.APIPath)
+	u.IndexerID = types.Int64Value(indexerURL.IndexerID)
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccParseIndexerURLFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			// Invalid URL
+			{
+				Config:      `output "test" { value = provider::prowlarr::parse_indexer_url("http://localhost:9696/api") }`,
+				ExpectError: regexp.MustCompile("indexer URL must end with"),
+			},
+			// Parse testing
+			{
+				Config: `output "test" { value = provider::prowlarr::parse_indexer_url("http://localhost:9696/prowlarr/5/api") }`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"prowlarr_url": knownvalue.StringExact("http://localhost:9696/prowlarr"),
+						"api_path":     knownvalue.StringExact("/api"),
+						"indexer_id":   knownvalue.Int64Exact(5),
+					})),
+				},
+			},
+		},
+	})
+}
@@ -2,26 +2,52 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/devopsarr/prowlarr-go/prowlarr"
 	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// default values for the retry settings.
+const (
+	defaultMaxRetries   = 3
+	defaultRetryWaitMin = 1
+	defaultRetryWaitMax = 30
+)
+
 // needed for tf debug mode
 // var stderr = os.Stderr
 
+var errUnknownVersion = errors.New("the provider is not configured")
+
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ provider.Provider = &ProwlarrProvider{}
+var (
+	_ provider.Provider                       = &ProwlarrProvider{}
+	_ provider.ProviderWithValidateConfig     = &ProwlarrProvider{}
+	_ provider.ProviderWithEphemeralResources = &ProwlarrProvider{}
+	_ provider.ProviderWithFunctions          = &ProwlarrProvider{}
+)
 
 // ProwlarrProvider defines the provider implementation.
 type ProwlarrProvider struct {
@@ -33,11 +59,37 @@ type ProwlarrProvider struct {
 
 // Prowlarr describes the provider data model.
 type Prowlarr struct {
+	MaxRequestsPerSecond types.Float64 `tfsdk:"max_requests_per_second"`
+	BasicAuth            *BasicAuth    `tfsdk:"basic_auth"`
+	ExtraHeaders         types.Set     `tfsdk:"extra_headers"`
+	Instances            types.Map     `tfsdk:"instances"`
+	HeadersFromEnvFile   types.String  `tfsdk:"headers_from_env_file"`
+	CACertificate        types.String  `tfsdk:"ca_certificate"`
+	ClientCertificate    types.String  `tfsdk:"client_certificate"`
+	ClientKey            types.String  `tfsdk:"client_key"`
+	URL                  types.String  `tfsdk:"url"`
+	APIKey               types.String  `tfsdk:"api_key"`
+	RetryWaitMin         types.Int64   `tfsdk:"retry_wait_min"`
+	RetryWaitMax         types.Int64   `tfsdk:"retry_wait_max"`
+	RequestTimeout       types.Int64   `tfsdk:"request_timeout"`
+	MaxRetries           types.Int64   `tfsdk:"max_retries"`
+	InsecureSkipVerify   types.Bool    `tfsdk:"insecure_skip_verify"`
+	VerifyConnection     types.Bool    `tfsdk:"verify_connection"`
+}
+
+// Instance is part of Prowlarr.
+type Instance struct {
 	ExtraHeaders types.Set    `tfsdk:"extra_headers"`
 	APIKey       types.String `tfsdk:"api_key"`
 	URL          types.String `tfsdk:"url"`
 }
 
+// BasicAuth is part of Prowlarr.
+type BasicAuth struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
 // ExtraHeader is part of Prowlarr.
 type ExtraHeader struct {
 	Name  types.String `tfsdk:"name"`
@@ -48,6 +100,60 @@ type ExtraHeader struct {
 type ProwlarrData struct {
 	Auth   context.Context
 	Client *prowlarr.APIClient
+	// Instances are the named instances, selected by the resource instance attribute.
+	Instances map[string]*ProwlarrData
+	// version reads the Prowlarr version, see Version.
+	version func() (string, error)
+	// schemas caches the indexer schemas, see IndexerSchemas.
+	schemas []prowlarr.IndexerResource
+	// categories caches the indexer categories, see IndexerCategories.
+	categories []prowlarr.IndexerCategory
+	// cache guards the cached values.
+	cache sync.Mutex
+}
+
+// Version returns the Prowlarr version, or the error met while reading it.
+// It is read at most once and only if a resource requires a specific version.
+func (d *ProwlarrData) Version() (string, error) {
+	if d.version == nil {
+		return "", errUnknownVersion
+	}
+
+	return d.version()
+}
+
+// IndexerSchemas returns the indexer schemas, fetching them only once to avoid a call at every plan.
+func (d *ProwlarrData) IndexerSchemas() ([]prowlarr.IndexerResource, error) {
+	d.cache.Lock()
+	defer d.cache.Unlock()
+
+	if d.schemas == nil {
+		schemas, _, err := d.Client.IndexerAPI.ListIndexerSchema(d.Auth).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		d.schemas = schemas
+	}
+
+	return d.schemas, nil
+}
+
+// IndexerCategories returns the indexer categories, fetching them only once to avoid a call at every plan.
+func (d *ProwlarrData) IndexerCategories() ([]prowlarr.IndexerCategory, error) {
+	d.cache.Lock()
+	defer d.cache.Unlock()
+
+	if d.categories == nil {
+		categories, _, err := d.Client.IndexerDefaultCategoriesAPI.ListIndexerCategories(d.Auth).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		d.categories = categories
+	}
+
+	return d.categories, nil
 }
 
 func (p *ProwlarrProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -65,25 +171,126 @@ func (p *ProwlarrProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 				Sensitive:           true,
 			},
 			"url": schema.StringAttribute{
-				MarkdownDescription: "Full Prowlarr URL with protocol and port (e.g. `https://test.prowlarr.audio:8686`). You should **NOT** supply any path (`/api`), the SDK will use the appropriate paths. Can be specified via the `PROWLARR_URL` environment variable.",
+				MarkdownDescription: "Full Prowlarr URL with protocol and port (e.g. `https://test.prowlarr.audio:8686`). You should **NOT** supply any path (`/api`), the SDK will use the appropriate paths. To connect through a Unix socket use the `unix` scheme with the socket path (e.g. `unix:///run/prowlarr/prowlarr.sock`). Can be specified via the `PROWLARR_URL` environment variable.",
+				Optional:            true,
+			},
+			"verify_connection": schema.BoolAttribute{
+				MarkdownDescription: "Call the system status endpoint when configuring the provider, failing early with a clear error for an invalid API key, a wrong URL or a network failure. Defaults to `false`.",
 				Optional:            true,
 			},
 			"extra_headers": schema.SetNestedAttribute{
 				MarkdownDescription: "Extra headers to be sent along with all Prowlarr requests. If this attribute is unset, it can be specified via environment variables following this pattern `PROWLARR_EXTRA_HEADER_${Header-Name}=${Header-Value}`.",
 				Optional:            true,
+				NestedObject:        extraHeaderSchema(),
+			},
+			"instances": schema.MapNestedAttribute{
+				MarkdownDescription: "Additional Prowlarr instances, keyed by the name used in the `instance` attribute of resources and data sources. All the other settings are shared with the default instance. If set, the default `url` and `api_key` are optional.",
+				Optional:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							MarkdownDescription: "Header name.",
+						"url": schema.StringAttribute{
+							MarkdownDescription: "Full Prowlarr URL with protocol and port.",
 							Required:            true,
 						},
-						"value": schema.StringAttribute{
-							MarkdownDescription: "Header value.",
+						"api_key": schema.StringAttribute{
+							MarkdownDescription: "API key for Prowlarr authentication.",
 							Required:            true,
+							Sensitive:           true,
+						},
+						"extra_headers": schema.SetNestedAttribute{
+							MarkdownDescription: "Extra headers to be sent along with all the instance requests. If this attribute is unset, the default instance ones are used.",
+							Optional:            true,
+							NestedObject:        extraHeaderSchema(),
 						},
 					},
 				},
 			},
+			"headers_from_env_file": schema.StringAttribute{
+				MarkdownDescription: "Path to an env file with a `Header-Name=value` pair per line, sent along with all Prowlarr requests. Empty lines and `#` comments are ignored and the `PROWLARR_EXTRA_HEADER_` prefix is stripped from names, so the same file can be used to export the extra headers environment variables. Can be specified via the `PROWLARR_HEADERS_FROM_ENV_FILE` environment variable.",
+				Optional:            true,
+			},
+			"basic_auth": schema.SingleNestedAttribute{
+				MarkdownDescription: "Basic authentication credentials for a reverse proxy in front of Prowlarr. If this attribute is unset, it can be specified via the `PROWLARR_BASIC_AUTH_USERNAME` and `PROWLARR_BASIC_AUTH_PASSWORD` environment variables.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						MarkdownDescription: "Username.",
+						Required:            true,
+					},
+					"password": schema.StringAttribute{
+						MarkdownDescription: "Password.",
+						Required:            true,
+						Sensitive:           true,
+					},
+				},
+			},
+			"ca_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificate, or path to a file containing it, used to verify the Prowlarr server certificate in addition to the system ones. Can be specified via the `PROWLARR_CA_CERTIFICATE` environment variable.",
+				Optional:            true,
+			},
+			"client_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate, or path to a file containing it, for mutual TLS authentication. Requires `client_key`. Can be specified via the `PROWLARR_CLIENT_CERTIFICATE` environment variable.",
+				Optional:            true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client private key, or path to a file containing it, for mutual TLS authentication. Requires `client_certificate`. Can be specified via the `PROWLARR_CLIENT_KEY` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip the verification of the Prowlarr server certificate. Use only for testing. Can be specified via the `PROWLARR_INSECURE_SKIP_VERIFY` environment variable.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries of a failed request. Network errors, `502` and `504` responses are retried for idempotent requests only, `429` and `503` responses for every request. Defaults to `3`, set `0` to disable retries.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_min": schema.Int64Attribute{
+				MarkdownDescription: "Wait in seconds before the first retry, doubled at each subsequent retry. Defaults to `1`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_max": schema.Int64Attribute{
+				MarkdownDescription: "Maximum wait in seconds between retries, also capping the `Retry-After` header sent by the server. Defaults to `30`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"request_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout in seconds of every single request attempt; timed out idempotent requests are retried. Defaults to `0`, meaning no timeout.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum number of requests per second sent to Prowlarr. Defaults to `0`, meaning no limit.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+func extraHeaderSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Header name.",
+				Required:            true,
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Header value.",
+				Required:            true,
+			},
 		},
 	}
 }
@@ -97,76 +304,80 @@ func (p *ProwlarrProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
-	// Extract URL
-	APIURL := data.URL.ValueString()
-	if APIURL == "" {
-		APIURL = os.Getenv("PROWLARR_URL")
+	instances := make(map[string]Instance, len(data.Instances.Elements()))
+	resp.Diagnostics.Append(data.Instances.ElementsAs(ctx, &instances, false)...)
+
+	prowlarrData := ProwlarrData{
+		Instances: make(map[string]*ProwlarrData, len(instances)),
 	}
 
-	parsedAPIURL, err := url.Parse(APIURL)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to find valid URL",
-			"URL cannot parsed",
-		)
+	// Default instance is optional when named instances are set
+	if len(instances) == 0 || data.URL.ValueString() != "" || os.Getenv("PROWLARR_URL") != "" {
+		data.configure(ctx, &prowlarrData, &resp.Diagnostics)
+	}
+
+	for name, instance := range instances {
+		config := data
+		config.URL = instance.URL
+		config.APIKey = instance.APIKey
 
+		if !instance.ExtraHeaders.IsNull() {
+			config.ExtraHeaders = instance.ExtraHeaders
+		}
+
+		instanceData := ProwlarrData{}
+		config.configure(ctx, &instanceData, &resp.Diagnostics)
+		prowlarrData.Instances[name] = &instanceData
+	}
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Extract key
-	key := data.APIKey.ValueString()
-	if key == "" {
-		key = os.Getenv("PROWLARR_API_KEY")
+	resp.DataSourceData = &prowlarrData
+	resp.ResourceData = &prowlarrData
+	resp.EphemeralResourceData = &prowlarrData
+}
+
+func (p *ProwlarrProvider) ValidateConfig(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var data Prowlarr
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if key == "" {
-		resp.Diagnostics.AddError(
-			"Unable to find API key",
-			"API key cannot be an empty string",
-		)
+	validateURL(data.URL, path.Root("url"), &resp.Diagnostics)
 
+	if data.Instances.IsUnknown() {
 		return
 	}
 
-	// Init config
-	config := prowlarr.NewConfiguration()
-	// Check extra headers
-	if len(data.ExtraHeaders.Elements()) > 0 {
-		headers := make([]ExtraHeader, len(data.ExtraHeaders.Elements()))
-		resp.Diagnostics.Append(data.ExtraHeaders.ElementsAs(ctx, &headers, false)...)
+	instances := make(map[string]Instance, len(data.Instances.Elements()))
+	resp.Diagnostics.Append(data.Instances.ElementsAs(ctx, &instances, false)...)
 
-		for _, header := range headers {
-			config.AddDefaultHeader(header.Name.ValueString(), header.Value.ValueString())
-		}
-	} else {
-		env := os.Environ()
-		for _, v := range env {
-			if strings.HasPrefix(v, "PROWLARR_EXTRA_HEADER_") {
-				header := strings.Split(v, "=")
-				config.AddDefaultHeader(strings.TrimPrefix(header[0], "PROWLARR_EXTRA_HEADER_"), header[1])
-			}
-		}
+	for name, instance := range instances {
+		validateURL(instance.URL, path.Root("instances").AtMapKey(name).AtName("url"), &resp.Diagnostics)
 	}
+}
 
-	// Set context for API calls
-	auth := context.WithValue(
-		context.Background(),
-		prowlarr.ContextAPIKeys,
-		map[string]prowlarr.APIKey{
-			"X-Api-Key": {Key: key},
-		},
-	)
-	auth = context.WithValue(auth, prowlarr.ContextServerVariables, map[string]string{
-		"protocol": parsedAPIURL.Scheme,
-		"hostpath": parsedAPIURL.Host + parsedAPIURL.Path,
-	})
+// validateURL checks the URL attribute if known, as it can be unknown until apply or set via environment variable.
+func validateURL(value types.String, attrPath path.Path, diags *diag.Diagnostics) {
+	if value.IsUnknown() || value.IsNull() {
+		return
+	}
 
-	prowlarrData := ProwlarrData{
-		Auth:   auth,
-		Client: prowlarr.NewAPIClient(config),
+	_, warning, err := helpers.ParseURL(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(attrPath, "Invalid URL", err.Error())
+
+		return
+	}
+
+	if warning != "" {
+		diags.AddAttributeWarning(attrPath, "Insecure URL", warning)
 	}
-	resp.DataSourceData = &prowlarrData
-	resp.ResourceData = &prowlarrData
 }
 
 func (p *ProwlarrProvider) Resources(_ context.Context) []func() resource.Resource {
@@ -181,6 +392,7 @@ func (p *ProwlarrProvider) Resources(_ context.Context) []func() resource.Resour
 		NewApplicationReadarrResource,
 		NewApplicationSonarrResource,
 		NewApplicationWhisparrResource,
+		NewApplicationBulkResource,
 
 		// Download Clients
 		NewDownloadClientResource,
@@ -202,6 +414,7 @@ func (p *ProwlarrProvider) Resources(_ context.Context) []func() resource.Resour
 		NewDownloadClientUsenetDownloadStationResource,
 		NewDownloadClientUtorrentResource,
 		NewDownloadClientVuzeResource,
+		NewDownloadClientBulkResource,
 
 		// Indexer Proxies
 		NewIndexerProxyResource,
@@ -212,6 +425,9 @@ func (p *ProwlarrProvider) Resources(_ context.Context) []func() resource.Resour
 
 		// Indexer
 		NewIndexerResource,
+		NewIndexerNewznabResource,
+		NewIndexerTorznabResource,
+		NewIndexerBulkResource,
 
 		// Notifications
 		NewNotificationResource,
@@ -237,6 +453,10 @@ func (p *ProwlarrProvider) Resources(_ context.Context) []func() resource.Resour
 
 		// System
 		NewHostResource,
+		NewUIConfigResource,
+		NewDevelopmentConfigResource,
+		NewBackupResource,
+		NewCommandResource,
 
 		// Tags
 		NewTagResource,
@@ -262,16 +482,26 @@ func (p *ProwlarrProvider) DataSources(_ context.Context) []func() datasource.Da
 		// Indexer
 		NewIndexerDataSource,
 		NewIndexersDataSource,
+		NewIndexerCategoriesDataSource,
 		NewIndexerSchemaDataSource,
 		NewIndexerSchemasDataSource,
+		NewIndexerStatusDataSource,
+		NewIndexerStatsDataSource,
+		NewSearchDataSource,
 
 		// Notifications
 		NewNotificationDataSource,
 		NewNotificationsDataSource,
 
 		// System
+		NewHealthDataSource,
+		NewHistoryDataSource,
 		NewHostDataSource,
 		NewSystemStatusDataSource,
+		NewSystemTasksDataSource,
+		NewUIConfigDataSource,
+		NewDevelopmentConfigDataSource,
+		NewBackupsDataSource,
 
 		// Tags
 		NewTagDataSource,
@@ -281,6 +511,24 @@ func (p *ProwlarrProvider) DataSources(_ context.Context) []func() datasource.Da
 	}
 }
 
+func (p *ProwlarrProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		// System
+		NewAPIKeyEphemeralResource,
+	}
+}
+
+func (p *ProwlarrProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		// Categories
+		NewCategoryIDFunction,
+		NewCategoryNameFunction,
+		NewCategoryChildrenFunction,
+		// Indexers
+		NewParseIndexerURLFunction,
+	}
+}
+
 // New returns the provider with a specific version.
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
@@ -290,11 +538,234 @@ func New(version string) func() provider.Provider {
 	}
 }
 
+// configure builds the client for the configured instance.
+func (p *Prowlarr) configure(ctx context.Context, prowlarrData *ProwlarrData, diags *diag.Diagnostics) {
+	// Extract URL
+	APIURL := p.URL.ValueString()
+	if APIURL == "" {
+		APIURL = os.Getenv("PROWLARR_URL")
+	}
+
+	parsedAPIURL, warning, err := helpers.ParseURL(APIURL)
+	if err != nil {
+		diags.AddError(
+			"Unable to find valid URL",
+			fmt.Sprintf("Set a valid url attribute or PROWLARR_URL environment variable: %s", err),
+		)
+
+		return
+	}
+
+	// warning for the attribute is already raised by ValidateConfig
+	if warning != "" && p.URL.ValueString() == "" {
+		diags.AddWarning("Insecure URL", warning)
+	}
+
+	// Extract key
+	key := p.APIKey.ValueString()
+	if key == "" {
+		key = os.Getenv("PROWLARR_API_KEY")
+	}
+
+	if key == "" {
+		diags.AddError(
+			"Unable to find API key",
+			"API key cannot be an empty string",
+		)
+
+		return
+	}
+
+	// Connect through Unix socket
+	var socket string
+
+	if parsedAPIURL.Scheme == helpers.UnixScheme {
+		socket = parsedAPIURL.Path
+		parsedAPIURL = &url.URL{Scheme: "http", Host: "localhost"}
+	}
+
+	// Init config
+	config := prowlarr.NewConfiguration()
+	p.configureHeaders(ctx, config, diags)
+	config.HTTPClient = p.httpClient(socket, diags)
+
+	if diags.HasError() {
+		return
+	}
+
+	// Set context for API calls
+	auth := context.WithValue(
+		context.Background(),
+		prowlarr.ContextAPIKeys,
+		map[string]prowlarr.APIKey{
+			"X-Api-Key": {Key: key},
+		},
+	)
+	auth = context.WithValue(auth, prowlarr.ContextServerVariables, map[string]string{
+		"protocol": parsedAPIURL.Scheme,
+		"hostpath": parsedAPIURL.Host + parsedAPIURL.Path,
+	})
+
+	prowlarrData.Auth = auth
+	prowlarrData.Client = prowlarr.NewAPIClient(config)
+
+	if p.VerifyConnection.ValueBool() {
+		status := helpers.VerifyConnection(auth, prowlarrData.Client, diags)
+		if diags.HasError() {
+			return
+		}
+
+		version := status.GetVersion()
+		prowlarrData.version = func() (string, error) { return version, nil }
+
+		return
+	}
+
+	// Read version lazily, only for the resources requiring a specific one
+	prowlarrData.version = sync.OnceValues(func() (string, error) {
+		status, _, err := prowlarrData.Client.SystemAPI.GetSystemStatus(auth).Execute()
+		if err != nil {
+			return "", err
+		}
+
+		return status.GetVersion(), nil
+	})
+}
+
+// configureHeaders adds the extra headers and the basic authentication to the client configuration.
+func (p *Prowlarr) configureHeaders(ctx context.Context, config *prowlarr.Configuration, diags *diag.Diagnostics) {
+	// Check extra headers
+	if len(p.ExtraHeaders.Elements()) > 0 {
+		headers := make([]ExtraHeader, len(p.ExtraHeaders.Elements()))
+		diags.Append(p.ExtraHeaders.ElementsAs(ctx, &headers, false)...)
+
+		for _, header := range headers {
+			config.AddDefaultHeader(header.Name.ValueString(), header.Value.ValueString())
+		}
+	} else {
+		env := os.Environ()
+		for _, v := range env {
+			if strings.HasPrefix(v, "PROWLARR_EXTRA_HEADER_") {
+				header := strings.Split(v, "=")
+				config.AddDefaultHeader(strings.TrimPrefix(header[0], "PROWLARR_EXTRA_HEADER_"), header[1])
+			}
+		}
+	}
+
+	// Read headers from env file
+	if file := stringValueOrEnv(p.HeadersFromEnvFile, "PROWLARR_HEADERS_FROM_ENV_FILE"); file != "" {
+		headers, err := helpers.ReadHeadersFile(file)
+		if err != nil {
+			diags.AddError(
+				"Unable to read headers file",
+				err.Error(),
+			)
+
+			return
+		}
+
+		for name, value := range headers {
+			config.AddDefaultHeader(name, value)
+		}
+	}
+
+	// Set basic authentication
+	basicAuth := p.BasicAuth
+	if basicAuth == nil && os.Getenv("PROWLARR_BASIC_AUTH_USERNAME") != "" {
+		basicAuth = &BasicAuth{
+			Username: types.StringValue(os.Getenv("PROWLARR_BASIC_AUTH_USERNAME")),
+			Password: types.StringValue(os.Getenv("PROWLARR_BASIC_AUTH_PASSWORD")),
+		}
+	}
+
+	if basicAuth != nil {
+		config.AddDefaultHeader("Authorization", helpers.BasicAuthHeader(basicAuth.Username.ValueString(), basicAuth.Password.ValueString()))
+	}
+}
+
+// httpClient builds the HTTP client with TLS, retries and rate limiting, connecting to the socket if not empty.
+func (p *Prowlarr) httpClient(socket string, diags *diag.Diagnostics) *http.Client {
+	// Configure TLS
+	insecure := p.InsecureSkipVerify.ValueBool()
+	if p.InsecureSkipVerify.IsNull() {
+		insecure, _ = strconv.ParseBool(os.Getenv("PROWLARR_INSECURE_SKIP_VERIFY"))
+	}
+
+	tlsConfig, err := helpers.TLSConfig(
+		stringValueOrEnv(p.CACertificate, "PROWLARR_CA_CERTIFICATE"),
+		stringValueOrEnv(p.ClientCertificate, "PROWLARR_CLIENT_CERTIFICATE"),
+		stringValueOrEnv(p.ClientKey, "PROWLARR_CLIENT_KEY"),
+		insecure,
+	)
+	if err != nil {
+		diags.AddError(
+			"Unable to configure TLS",
+			err.Error(),
+		)
+
+		return nil
+	}
+
+	transport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		transport = &http.Transport{}
+	}
+
+	transport = transport.Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	if socket != "" {
+		transport.DialContext = helpers.UnixSocketDialContext(socket)
+	}
+
+	// Retry and rate limit requests
+	waitMin := int64Default(p.RetryWaitMin, defaultRetryWaitMin)
+	waitMax := int64Default(p.RetryWaitMax, defaultRetryWaitMax)
+
+	if waitMax < waitMin {
+		diags.AddError(
+			"Invalid retry configuration",
+			"retry_wait_max cannot be lower than retry_wait_min",
+		)
+
+		return nil
+	}
+
+	return &http.Client{
+		Transport: helpers.NewRetryTransport(
+			transport,
+			int(int64Default(p.MaxRetries, defaultMaxRetries)),
+			time.Duration(waitMin)*time.Second,
+			time.Duration(waitMax)*time.Second,
+			time.Duration(p.RequestTimeout.ValueInt64())*time.Second,
+			p.MaxRequestsPerSecond.ValueFloat64(),
+		),
+	}
+}
+
+// stringValueOrEnv returns the attribute value or the environment variable if not set.
+func stringValueOrEnv(value types.String, env string) string {
+	if value.ValueString() != "" {
+		return value.ValueString()
+	}
+
+	return os.Getenv(env)
+}
+
+// int64Default returns the attribute value or the default if not set.
+func int64Default(value types.Int64, defaultValue int64) int64 {
+	if value.IsNull() || value.IsUnknown() {
+		return defaultValue
+	}
+
+	return value.ValueInt64()
+}
+
 // ResourceConfigure is a helper function to set the client for a specific resource.
-func resourceConfigure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) (context.Context, *prowlarr.APIClient) {
+func resourceConfigure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) *ProwlarrData {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
-		return nil, nil
+		return nil
 	}
 
 	providerData, ok := req.ProviderData.(*ProwlarrData)
@@ -304,16 +775,36 @@ func resourceConfigure(_ context.Context, req resource.ConfigureRequest, resp *r
 			fmt.Sprintf("Expected *ProwlarrData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
-		return nil, nil
+		return nil
 	}
 
-	return providerData.Auth, providerData.Client
+	return providerData
+}
+
+// checkVersion adds an error on the attribute if the Prowlarr version is outside the minimum and maximum versions supported by the resource.
+// Empty bounds are ignored. A warning is added if the version cannot be read, instead of silently skipping the check.
+func checkVersion(providerData *ProwlarrData, attribute path.Path, name, minimum, maximum string, diags *diag.Diagnostics) {
+	if providerData == nil {
+		return
+	}
+
+	version, err := providerData.Version()
+	if err != nil {
+		diags.AddAttributeWarning(attribute, helpers.UnknownVersion,
+			fmt.Sprintf("Unable to check that prowlarr_%s supports the Prowlarr version: %s", name, err))
+
+		return
+	}
+
+	if msg := helpers.CheckVersion("prowlarr_"+name, version, minimum, maximum); msg != "" {
+		diags.AddAttributeError(attribute, helpers.UnsupportedVersion, msg)
+	}
 }
 
-func dataSourceConfigure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) (context.Context, *prowlarr.APIClient) {
+func dataSourceConfigure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) *ProwlarrData {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
-		return nil, nil
+		return nil
 	}
 
 	providerData, ok := req.ProviderData.(*ProwlarrData)
@@ -323,8 +814,8 @@ func dataSourceConfigure(_ context.Context, req datasource.ConfigureRequest, res
 			fmt.Sprintf("Expected *ProwlarrData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
-		return nil, nil
+		return nil
 	}
 
-	return providerData.Auth, providerData.Client
+	return providerData
 }
@@ -1,11 +1,20 @@
 package provider
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"sync/atomic"
 	"testing"
 
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -40,3 +49,149 @@ provider "prowlarr" {
 	]
   }
 `
+
+func TestProwlarrDataVersion(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"version":"1.2.3"}`))
+	}))
+	defer server.Close()
+
+	var diags diag.Diagnostics
+
+	data := &ProwlarrData{}
+	config := Prowlarr{URL: types.StringValue(server.URL), APIKey: types.StringValue("key")}
+	config.configure(context.Background(), data, &diags)
+	assert.False(t, diags.HasError())
+
+	// the version is only read when needed, then it is cached
+	assert.Equal(t, int32(0), calls.Load())
+
+	for range 2 {
+		version, err := data.Version()
+		assert.NoError(t, err)
+		assert.Equal(t, "1.2.3", version)
+	}
+
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestCheckVersion(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	var diags diag.Diagnostics
+
+	// the version cannot be read, so it is not checked but the user is warned
+	unreadable := &ProwlarrData{}
+	config := Prowlarr{URL: types.StringValue(server.URL), APIKey: types.StringValue("key")}
+	config.configure(context.Background(), unreadable, &diags)
+	assert.False(t, diags.HasError())
+
+	checkVersion(unreadable, path.Root("name"), "test", "1.0.0", "", &diags)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, helpers.UnknownVersion, diags.Warnings()[0].Summary())
+
+	tests := map[string]struct {
+		version string
+		errors  int
+	}{
+		"supported": {
+			version: "1.2.3",
+		},
+		"unsupported": {
+			version: "0.9.0",
+			errors:  1,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			data := &ProwlarrData{version: func() (string, error) { return test.version, nil }}
+			checkVersion(data, path.Root("name"), "test", "1.0.0", "", &diags)
+			assert.Equal(t, test.errors, diags.ErrorsCount())
+			assert.Empty(t, diags.Warnings())
+		})
+	}
+}
+
+func TestProwlarrDataIndexerSchemas(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		// the first call fails, it must not be cached
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"implementation":"Cardigann"}]`))
+	}))
+	defer server.Close()
+
+	var diags diag.Diagnostics
+
+	data := &ProwlarrData{}
+	config := Prowlarr{URL: types.StringValue(server.URL), APIKey: types.StringValue("key")}
+	config.configure(context.Background(), data, &diags)
+	assert.False(t, diags.HasError())
+
+	_, err := data.IndexerSchemas()
+	assert.Error(t, err)
+
+	for range 2 {
+		schemas, err := data.IndexerSchemas()
+		assert.NoError(t, err)
+		assert.Len(t, schemas, 1)
+	}
+
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestProwlarrDataIndexerCategories(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id":2000,"name":"Movies"}]`))
+	}))
+	defer server.Close()
+
+	var diags diag.Diagnostics
+
+	// each instance has its own cache
+	first, second := &ProwlarrData{}, &ProwlarrData{}
+	config := Prowlarr{URL: types.StringValue(server.URL), APIKey: types.StringValue("key")}
+	config.configure(context.Background(), first, &diags)
+	config.configure(context.Background(), second, &diags)
+	assert.False(t, diags.HasError())
+
+	for _, data := range []*ProwlarrData{first, first, second} {
+		categories, err := data.IndexerCategories()
+		assert.NoError(t, err)
+		assert.Len(t, categories, 1)
+	}
+
+	assert.Equal(t, int32(2), calls.Load())
+}
@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	searchDataSourceName = "search"
+	searchDefaultLimit   = 100
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SearchDataSource{}
+
+func NewSearchDataSource() datasource.DataSource {
+	return &SearchDataSource{}
+}
+
+// SearchDataSource defines the search implementation.
+type SearchDataSource struct {
+	data *ProwlarrData
+}
+
+// Search describes the search data model.
+type Search struct {
+	Instance   types.String `tfsdk:"instance"`
+	Releases   types.List   `tfsdk:"releases"`
+	Categories types.Set    `tfsdk:"categories"`
+	IndexerIDs types.Set    `tfsdk:"indexer_ids"`
+	Query      types.String `tfsdk:"query"`
+	Type       types.String `tfsdk:"type"`
+	ID         types.String `tfsdk:"id"`
+	Limit      types.Int64  `tfsdk:"limit"`
+}
+
+// Release is part of Search.
+type Release struct {
+	Categories  types.Set    `tfsdk:"categories"`
+	Title       types.String `tfsdk:"title"`
+	GUID        types.String `tfsdk:"guid"`
+	Indexer     types.String `tfsdk:"indexer"`
+	PublishDate types.String `tfsdk:"publish_date"`
+	Protocol    types.String `tfsdk:"protocol"`
+	IndexerID   types.Int64  `tfsdk:"indexer_id"`
+	Size        types.Int64  `tfsdk:"size"`
+	Seeders     types.Int64  `tfsdk:"seeders"`
+	Leechers    types.Int64  `tfsdk:"leechers"`
+	Grabs       types.Int64  `tfsdk:"grabs"`
+}
+
+func (d *SearchDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + searchDataSourceName
+}
+
+func (d *SearchDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Indexers -->\nSearch releases through the configured [Indexers](../resources/indexer).\nFor more information refer to [Search](https://wiki.servarr.com/prowlarr/search) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Search query.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Search type. Valid values are `search`, `tvsearch`, `movie`, `music` and `book`. Defaults to `search`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("search", "tvsearch", "movie", "music", "book"),
+				},
+			},
+			"categories": schema.SetAttribute{
+				MarkdownDescription: "Category IDs to search into.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"indexer_ids": schema.SetAttribute{
+				MarkdownDescription: "Indexer IDs to search into. If empty, all the enabled indexers are used.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of returned releases. Defaults to `100`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"releases": schema.ListNestedAttribute{
+				MarkdownDescription: "Release list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Release title.",
+							Computed:            true,
+						},
+						"guid": schema.StringAttribute{
+							MarkdownDescription: "Release GUID.",
+							Computed:            true,
+						},
+						"indexer": schema.StringAttribute{
+							MarkdownDescription: "Indexer name.",
+							Computed:            true,
+						},
+						"indexer_id": schema.Int64Attribute{
+							MarkdownDescription: "Indexer ID.",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "Size in bytes.",
+							Computed:            true,
+						},
+						"seeders": schema.Int64Attribute{
+							MarkdownDescription: "Seeders. Null for usenet releases.",
+							Computed:            true,
+						},
+						"leechers": schema.Int64Attribute{
+							MarkdownDescription: "Leechers. Null for usenet releases.",
+							Computed:            true,
+						},
+						"grabs": schema.Int64Attribute{
+							MarkdownDescription: "Grabs.",
+							Computed:            true,
+						},
+						"publish_date": schema.StringAttribute{
+							MarkdownDescription: "Publish date (RFC3339).",
+							Computed:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "Protocol. Can be `usenet` or `torrent`.",
+							Computed:            true,
+						},
+						"categories": schema.SetAttribute{
+							MarkdownDescription: "Category IDs.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SearchDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.data = dataSourceConfigure(ctx, req, resp)
+}
+
+func (d *SearchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Search
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := d.data.instance(data.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	limit := int(data.Limit.ValueInt64())
+	if data.Limit.IsNull() {
+		limit = searchDefaultLimit
+	}
+
+	searchType := data.Type.ValueString()
+	if data.Type.IsNull() {
+		searchType = "search"
+	}
+
+	categories := make([]int32, len(data.Categories.Elements()))
+	resp.Diagnostics.Append(data.Categories.ElementsAs(ctx, &categories, true)...)
+
+	indexerIDs := make([]int32, len(data.IndexerIDs.Elements()))
+	resp.Diagnostics.Append(data.IndexerIDs.ElementsAs(ctx, &indexerIDs, true)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Search releases
+	response, _, err := instance.Client.SearchAPI.ListSearch(instance.Auth).
+		Query(data.Query.ValueString()).
+		Type_(searchType).
+		Categories(categories).
+		IndexerIds(indexerIDs).
+		Limit(int32(limit)).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, searchDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+searchDataSourceName)
+
+	// Results are aggregated from multiple indexers, so the limit is enforced here as well
+	if len(response) > limit {
+		response = response[:limit]
+	}
+
+	// Map response body to resource schema attribute
+	releases := make([]Release, len(response))
+	for i, r := range response {
+		releases[i].write(ctx, &r)
+	}
+
+	tfsdk.ValueFrom(ctx, releases, data.Releases.Type(ctx), &data.Releases)
+	// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+	data.ID = types.StringValue(strconv.Itoa(len(releases)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *Release) write(ctx context.Context, release *prowlarr.ReleaseResource) {
+	categories := make([]int64, len(release.GetCategories()))
+	for i, c := range release.GetCategories() {
+		categories[i] = int64(c.GetId())
+	}
+
+	publishDate, _ := release.GetPublishDateOk()
+
+	r.Title = types.StringValue(release.GetTitle())
+	r.GUID = types.StringValue(release.GetGuid())
+	r.Indexer = types.StringValue(release.GetIndexer())
+	r.IndexerID = types.Int64Value(int64(release.GetIndexerId()))
+	r.Size = types.Int64Value(release.GetSize())
+	r.Grabs = types.Int64Value(int64(release.GetGrabs()))
+	r.PublishDate = helpers.TimeValue(publishDate)
+	r.Protocol = types.StringValue(string(release.GetProtocol()))
+	r.Seeders = types.Int64Null()
+	r.Leechers = types.Int64Null()
+	r.Categories, _ = types.SetValueFrom(ctx, types.Int64Type, categories)
+
+	if seeders, _ := release.GetSeedersOk(); seeders != nil {
+		r.Seeders = types.Int64Value(int64(*seeders))
+	}
+
+	if leechers, _ := release.GetLeechersOk(); leechers != nil {
+		r.Leechers = types.Int64Value(int64(*leechers))
+	}
+}
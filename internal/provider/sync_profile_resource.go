@@ -31,8 +31,7 @@ func NewSyncProfileResource() resource.Resource {
 
 // SyncProfileResource defines the sync profile implementation.
 type SyncProfileResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // SyncProfile describes the sync profile data model.
@@ -45,6 +44,12 @@ type SyncProfile struct {
 	EnableAutomaticSearch   types.Bool   `tfsdk:"enable_automatic_search"`
 }
 
+// InstanceSyncProfile extends SyncProfile with the instance attribute, which the list elements do not have.
+type InstanceSyncProfile struct {
+	Instance types.String `tfsdk:"instance"`
+	SyncProfile
+}
+
 func (s SyncProfile) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -65,6 +70,7 @@ func (r *SyncProfileResource) Schema(_ context.Context, _ resource.SchemaRequest
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Applications -->\nSync Profile resource.\nFor more information refer to [Sync Profiles](https://wiki.servarr.com/prowlarr/settings#sync-profiles) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
 			"enable_rss": schema.BoolAttribute{
 				MarkdownDescription: "Enable RSS flag.",
 				Required:            true,
@@ -97,15 +103,12 @@ func (r *SyncProfileResource) Schema(_ context.Context, _ resource.SchemaRequest
 }
 
 func (r *SyncProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
-	}
+	r.data = resourceConfigure(ctx, req, resp)
 }
 
 func (r *SyncProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var profile *SyncProfile
+	var profile *InstanceSyncProfile
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
 
@@ -113,10 +116,17 @@ func (r *SyncProfileResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	instance, err := r.data.instance(profile.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Create new Sync Profile
 	request := profile.read()
 
-	response, _, err := r.client.AppProfileAPI.CreateAppProfile(r.auth).AppProfileResource(*request).Execute()
+	response, _, err := instance.Client.AppProfileAPI.CreateAppProfile(instance.Auth).AppProfileResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, syncProfileResourceName, err))
 
@@ -131,7 +141,7 @@ func (r *SyncProfileResource) Create(ctx context.Context, req resource.CreateReq
 
 func (r *SyncProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var profile *SyncProfile
+	var profile *InstanceSyncProfile
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &profile)...)
 
@@ -139,10 +149,17 @@ func (r *SyncProfileResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	instance, err := r.data.instance(profile.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get sync profile current value
-	response, _, err := r.client.AppProfileAPI.GetAppProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
+	response, httpResp, err := instance.Client.AppProfileAPI.GetAppProfileById(instance.Auth, int32(profile.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, syncProfileResourceName, err))
+		helpers.HandleReadError(ctx, syncProfileResourceName, err, httpResp, resp)
 
 		return
 	}
@@ -155,7 +172,7 @@ func (r *SyncProfileResource) Read(ctx context.Context, req resource.ReadRequest
 
 func (r *SyncProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var profile *SyncProfile
+	var profile *InstanceSyncProfile
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
 
@@ -163,10 +180,17 @@ func (r *SyncProfileResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	instance, err := r.data.instance(profile.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Update SyncProfile
 	request := profile.read()
 
-	response, _, err := r.client.AppProfileAPI.UpdateAppProfile(r.auth, fmt.Sprint(request.GetId())).AppProfileResource(*request).Execute()
+	response, _, err := instance.Client.AppProfileAPI.UpdateAppProfile(instance.Auth, fmt.Sprint(request.GetId())).AppProfileResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, syncProfileResourceName, err))
 
@@ -180,16 +204,27 @@ func (r *SyncProfileResource) Update(ctx context.Context, req resource.UpdateReq
 }
 
 func (r *SyncProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID   int64
+		name types.String
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	instance, err := r.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Delete sync profile current value
-	_, err := r.client.AppProfileAPI.DeleteAppProfile(r.auth, int32(ID)).Execute()
+	_, err = instance.Client.AppProfileAPI.DeleteAppProfile(instance.Auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, syncProfileResourceName, err))
 
@@ -201,6 +236,7 @@ func (r *SyncProfileResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *SyncProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importInstance(ctx, r.data, req.ID, resp)
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
 	tflog.Trace(ctx, "imported "+syncProfileResourceName+": "+req.ID)
 }
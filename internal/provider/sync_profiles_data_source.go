@@ -4,11 +4,10 @@ import (
 	"context"
 	"strconv"
 
-	"github.com/devopsarr/prowlarr-go/prowlarr"
 	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
-
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,12 +23,12 @@ func NewSyncProfilesDataSource() datasource.DataSource {
 
 // SyncProfilesDataSource defines the sync profiles implementation.
 type SyncProfilesDataSource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // SyncProfiles describes the sync profiles data model.
 type SyncProfiles struct {
+	Instance     types.String `tfsdk:"instance"`
 	SyncProfiles types.Set    `tfsdk:"sync_profiles"`
 	ID           types.String `tfsdk:"id"`
 }
@@ -43,6 +42,7 @@ func (d *SyncProfilesDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 		// This description is used by the documentation generator and the delay server.
 		MarkdownDescription: "<!-- subcategory:Applications -->\nList all available [Sync Profiles](../resources/sync_profile).",
 		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
 			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -84,15 +84,28 @@ func (d *SyncProfilesDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 }
 
 func (d *SyncProfilesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
-		d.client = client
-		d.auth = auth
-	}
+	d.data = dataSourceConfigure(ctx, req, resp)
 }
 
-func (d *SyncProfilesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *SyncProfilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the instance from config
+	var name types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := d.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get sync profiles current value
-	response, _, err := d.client.AppProfileAPI.ListAppProfile(d.auth).Execute()
+	response, _, err := instance.Client.AppProfileAPI.ListAppProfile(instance.Auth).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, syncProfilesDataSourceName, err))
 
@@ -108,5 +121,5 @@ func (d *SyncProfilesDataSource) Read(ctx context.Context, _ datasource.ReadRequ
 
 	profileList, diags := types.SetValueFrom(ctx, SyncProfile{}.getType(), profiles)
 	resp.Diagnostics.Append(diags...)
-	resp.Diagnostics.Append(resp.State.Set(ctx, SyncProfiles{SyncProfiles: profileList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, SyncProfiles{Instance: name, SyncProfiles: profileList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
 }
@@ -5,9 +5,9 @@ import (
 
 	"github.com/devopsarr/prowlarr-go/prowlarr"
 	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
-
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -23,12 +23,12 @@ func NewSystemStatusDataSource() datasource.DataSource {
 
 // SystemStatusDataSource defines the system status implementation.
 type SystemStatusDataSource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // SystemStatus describes the system status data model.
 type SystemStatus struct {
+	Instance                      types.String `tfsdk:"instance"`
 	AppName                       types.String `tfsdk:"app_name"`
 	InstanceName                  types.String `tfsdk:"instance_name"`
 	PackageUpdateMechanismMessage types.String `tfsdk:"package_update_mechanism_message"`
@@ -72,6 +72,7 @@ func (d *SystemStatusDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 		// This description is used by the documentation generator and the delay server.
 		MarkdownDescription: "<!-- subcategory:System -->\nSystem Status resource. User must have rights to read `config.xml`.\nFor more information refer to [System Status](https://wiki.servarr.com/prowlarr/system#status) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
 			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Delay Profile ID.",
@@ -206,15 +207,28 @@ func (d *SystemStatusDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 }
 
 func (d *SystemStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
-		d.client = client
-		d.auth = auth
-	}
+	d.data = dataSourceConfigure(ctx, req, resp)
 }
 
-func (d *SystemStatusDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *SystemStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the instance from config
+	var name types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := d.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get system status current value
-	response, _, err := d.client.SystemAPI.GetSystemStatus(d.auth).Execute()
+	response, _, err := instance.Client.SystemAPI.GetSystemStatus(instance.Auth).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, systemStatusDataSourceName, err))
 
@@ -223,7 +237,7 @@ func (d *SystemStatusDataSource) Read(ctx context.Context, _ datasource.ReadRequ
 
 	tflog.Trace(ctx, "read "+systemStatusDataSourceName)
 
-	status := SystemStatus{}
+	status := SystemStatus{Instance: name}
 	status.write(response)
 	resp.Diagnostics.Append(resp.State.Set(ctx, status)...)
 }
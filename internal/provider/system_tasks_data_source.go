@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const systemTasksDataSourceName = "system_tasks"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SystemTasksDataSource{}
+
+func NewSystemTasksDataSource() datasource.DataSource {
+	return &SystemTasksDataSource{}
+}
+
+// SystemTasksDataSource defines the system tasks implementation.
+type SystemTasksDataSource struct {
+	data *ProwlarrData
+}
+
+// SystemTasks describes the system tasks data model.
+type SystemTasks struct {
+	Instance types.String `tfsdk:"instance"`
+	Tasks    types.Set    `tfsdk:"tasks"`
+	ID       types.String `tfsdk:"id"`
+}
+
+// SystemTask is part of SystemTasks.
+type SystemTask struct {
+	Name          types.String `tfsdk:"name"`
+	TaskName      types.String `tfsdk:"task_name"`
+	LastExecution types.String `tfsdk:"last_execution"`
+	LastStartTime types.String `tfsdk:"last_start_time"`
+	NextExecution types.String `tfsdk:"next_execution"`
+	LastDuration  types.String `tfsdk:"last_duration"`
+	ID            types.Int64  `tfsdk:"id"`
+	Interval      types.Int64  `tfsdk:"interval"`
+}
+
+func (d *SystemTasksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + systemTasksDataSourceName
+}
+
+func (d *SystemTasksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:System -->\nList all scheduled System Tasks.\nFor more information refer to [Tasks](https://wiki.servarr.com/prowlarr/system#tasks) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"tasks": schema.SetNestedAttribute{
+				MarkdownDescription: "Task list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Task ID.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name.",
+							Computed:            true,
+						},
+						"task_name": schema.StringAttribute{
+							MarkdownDescription: "Task name, to be used as `prowlarr_command` name.",
+							Computed:            true,
+						},
+						"interval": schema.Int64Attribute{
+							MarkdownDescription: "Interval in minutes.",
+							Computed:            true,
+						},
+						"last_execution": schema.StringAttribute{
+							MarkdownDescription: "Last execution timestamp (RFC3339).",
+							Computed:            true,
+						},
+						"last_start_time": schema.StringAttribute{
+							MarkdownDescription: "Last start timestamp (RFC3339).",
+							Computed:            true,
+						},
+						"next_execution": schema.StringAttribute{
+							MarkdownDescription: "Next execution timestamp (RFC3339).",
+							Computed:            true,
+						},
+						"last_duration": schema.StringAttribute{
+							MarkdownDescription: "Last duration.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SystemTasksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.data = dataSourceConfigure(ctx, req, resp)
+}
+
+func (d *SystemTasksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *SystemTasks
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := d.data.instance(data.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	// Get system tasks current value
+	response, _, err := instance.Client.TaskAPI.ListSystemTask(instance.Auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, systemTasksDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+systemTasksDataSourceName)
+	// Map response body to resource schema attribute
+	tasks := make([]SystemTask, len(response))
+	for i, t := range response {
+		tasks[i].write(&t)
+	}
+
+	tfsdk.ValueFrom(ctx, tasks, data.Tasks.Type(ctx), &data.Tasks)
+	// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+	data.ID = types.StringValue(systemTasksDataSourceName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (t *SystemTask) write(task *prowlarr.TaskResource) {
+	lastExecution, _ := task.GetLastExecutionOk()
+	lastStartTime, _ := task.GetLastStartTimeOk()
+	nextExecution, _ := task.GetNextExecutionOk()
+
+	t.ID = types.Int64Value(int64(task.GetId()))
+	t.Name = types.StringValue(task.GetName())
+	t.TaskName = types.StringValue(task.GetTaskName())
+	t.Interval = types.Int64Value(int64(task.GetInterval()))
+	t.LastExecution = helpers.TimeValue(lastExecution)
+	t.LastStartTime = helpers.TimeValue(lastStartTime)
+	t.NextExecution = helpers.TimeValue(nextExecution)
+	t.LastDuration = types.StringValue(task.GetLastDuration())
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -22,8 +23,7 @@ func NewTagDataSource() datasource.DataSource {
 
 // TagDataSource defines the tag implementation.
 type TagDataSource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 func (d *TagDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -35,6 +35,7 @@ func (d *TagDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, re
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "<!-- subcategory:Tags -->\nSingle [Tag](../resources/tag).",
 		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Tag ID.",
 				Computed:            true,
@@ -48,14 +49,11 @@ func (d *TagDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, re
 }
 
 func (d *TagDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
-		d.client = client
-		d.auth = auth
-	}
+	d.data = dataSourceConfigure(ctx, req, resp)
 }
 
 func (d *TagDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data *Tag
+	var data *InstanceTag
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
@@ -63,8 +61,15 @@ func (d *TagDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		return
 	}
 
+	instance, err := d.data.instance(data.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get tags current value
-	response, _, err := d.client.TagAPI.ListTag(d.auth).Execute()
+	response, _, err := instance.Client.TagAPI.ListTag(instance.Auth).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, tagDataSourceName, err))
 
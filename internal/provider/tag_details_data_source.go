@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +25,7 @@ func NewTagDetailsDataSource() datasource.DataSource {
 
 // TagDetailsDataSource defines the tag details implementation.
 type TagDetailsDataSource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // Tag describes the tag data model.
@@ -38,6 +38,12 @@ type TagDetails struct {
 	ID              types.Int64  `tfsdk:"id"`
 }
 
+// InstanceTagDetails extends TagDetails with the instance attribute, which the list elements do not have.
+type InstanceTagDetails struct {
+	Instance types.String `tfsdk:"instance"`
+	TagDetails
+}
+
 func (t TagDetails) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -59,6 +65,7 @@ func (d *TagDetailsDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "<!-- subcategory:Tags -->\nSingle [Tag](../resources/tag) with its associated resources.",
 		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Tag ID.",
 				Computed:            true,
@@ -92,14 +99,11 @@ func (d *TagDetailsDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 }
 
 func (d *TagDetailsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
-		d.client = client
-		d.auth = auth
-	}
+	d.data = dataSourceConfigure(ctx, req, resp)
 }
 
 func (d *TagDetailsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data *TagDetails
+	var data *InstanceTagDetails
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
@@ -107,8 +111,15 @@ func (d *TagDetailsDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
+	instance, err := d.data.instance(data.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get tags current value
-	response, _, err := d.client.TagDetailsAPI.ListTagDetail(d.auth).Execute()
+	response, _, err := instance.Client.TagDetailsAPI.ListTagDetail(instance.Auth).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, tagDetailsDataSourceName, err))
 
@@ -34,8 +34,7 @@ func NewTagResource() resource.Resource {
 
 // TagResource defines the tag implementation.
 type TagResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // Tag describes the tag data model.
@@ -44,6 +43,12 @@ type Tag struct {
 	ID    types.Int64  `tfsdk:"id"`
 }
 
+// InstanceTag extends Tag with the instance attribute, which the list elements do not have.
+type InstanceTag struct {
+	Instance types.String `tfsdk:"instance"`
+	Tag
+}
+
 func (t Tag) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -60,6 +65,7 @@ func (r *TagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Tags -->\nTag resource.\nFor more information refer to [Tags](https://wiki.servarr.com/prowlarr/settings#tags) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceSchema(),
 			"label": schema.StringAttribute{
 				MarkdownDescription: "Tag label. It must be lowercase.",
 				Required:            true,
@@ -82,15 +88,12 @@ func (r *TagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 }
 
 func (r *TagResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
-	}
+	r.data = resourceConfigure(ctx, req, resp)
 }
 
 func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var tag *Tag
+	var tag *InstanceTag
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &tag)...)
 
@@ -98,11 +101,18 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	instance, err := r.data.instance(tag.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Create new Tag
 	request := *prowlarr.NewTagResource()
 	request.SetLabel(tag.Label.ValueString())
 
-	response, _, err := r.client.TagAPI.CreateTag(r.auth).TagResource(request).Execute()
+	response, _, err := instance.Client.TagAPI.CreateTag(instance.Auth).TagResource(request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, tagResourceName, err))
 
@@ -117,7 +127,7 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var tag *Tag
+	var tag *InstanceTag
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &tag)...)
 
@@ -125,10 +135,17 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
+	instance, err := r.data.instance(tag.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get tag current value
-	response, _, err := r.client.TagAPI.GetTagById(r.auth, int32(tag.ID.ValueInt64())).Execute()
+	response, httpResp, err := instance.Client.TagAPI.GetTagById(instance.Auth, int32(tag.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, tagResourceName, err))
+		helpers.HandleReadError(ctx, tagResourceName, err, httpResp, resp)
 
 		return
 	}
@@ -141,7 +158,7 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var tag *Tag
+	var tag *InstanceTag
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &tag)...)
 
@@ -149,12 +166,19 @@ func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	instance, err := r.data.instance(tag.Instance.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Update Tag
 	tagResource := *prowlarr.NewTagResource()
 	tagResource.SetLabel(tag.Label.ValueString())
 	tagResource.SetId(int32(tag.ID.ValueInt64()))
 
-	response, _, err := r.client.TagAPI.UpdateTag(r.auth, fmt.Sprint(tagResource.GetId())).TagResource(tagResource).Execute()
+	response, _, err := instance.Client.TagAPI.UpdateTag(instance.Auth, fmt.Sprint(tagResource.GetId())).TagResource(tagResource).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, tagResourceName, err))
 
@@ -168,16 +192,27 @@ func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 }
 
 func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID   int64
+		name types.String
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	instance, err := r.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Delete tag current value
-	_, err := r.client.TagAPI.DeleteTag(r.auth, int32(ID)).Execute()
+	_, err = instance.Client.TagAPI.DeleteTag(instance.Auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, tagResourceName, err))
 
@@ -189,6 +224,7 @@ func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 }
 
 func (r *TagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importInstance(ctx, r.data, req.ID, resp)
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
 	tflog.Trace(ctx, "imported "+tagResourceName+": "+req.ID)
 }
@@ -4,10 +4,10 @@ import (
 	"context"
 	"strconv"
 
-	"github.com/devopsarr/prowlarr-go/prowlarr"
 	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -23,14 +23,14 @@ func NewTagsDetailsDataSource() datasource.DataSource {
 
 // TagsDetailsDataSource defines the tags details implementation.
 type TagsDetailsDataSource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	data *ProwlarrData
 }
 
 // Tags describes the tags data model.
 type TagsDetails struct {
-	Tags types.Set    `tfsdk:"tags"`
-	ID   types.String `tfsdk:"id"`
+	Instance types.String `tfsdk:"instance"`
+	Tags     types.Set    `tfsdk:"tags"`
+	ID       types.String `tfsdk:"id"`
 }
 
 func (d *TagsDetailsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -42,6 +42,7 @@ func (d *TagsDetailsDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "<!-- subcategory:Tags -->\n[Tag](../resources/tag) list with their associated resources.",
 		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
 			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -87,15 +88,28 @@ func (d *TagsDetailsDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 }
 
 func (d *TagsDetailsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
-		d.client = client
-		d.auth = auth
-	}
+	d.data = dataSourceConfigure(ctx, req, resp)
 }
 
-func (d *TagsDetailsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *TagsDetailsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the instance from config
+	var name types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := d.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
 	// Get tagss current value
-	response, _, err := d.client.TagDetailsAPI.ListTagDetail(d.auth).Execute()
+	response, _, err := instance.Client.TagDetailsAPI.ListTagDetail(instance.Auth).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, tagsDetailsDataSourceName, err))
 
@@ -111,5 +125,5 @@ func (d *TagsDetailsDataSource) Read(ctx context.Context, _ datasource.ReadReque
 
 	tagList, diags := types.SetValueFrom(ctx, TagDetails{}.getType(), tags)
 	resp.Diagnostics.Append(diags...)
-	resp.Diagnostics.Append(resp.State.Set(ctx, TagsDetails{Tags: tagList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, TagsDetails{Instance: name, Tags: tagList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
 }
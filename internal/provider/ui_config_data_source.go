@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const uiConfigDataSourceName = "ui_config"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UIConfigDataSource{}
+
+func NewUIConfigDataSource() datasource.DataSource {
+	return &UIConfigDataSource{}
+}
+
+// UIConfigDataSource defines the UI config implementation.
+type UIConfigDataSource struct {
+	data *ProwlarrData
+}
+
+func (d *UIConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + uiConfigDataSourceName
+}
+
+func (d *UIConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:System -->\n[UI Config](../resources/ui_config).",
+		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceSchema(),
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "UI Config ID.",
+				Computed:            true,
+			},
+			"first_day_of_week": schema.Int64Attribute{
+				MarkdownDescription: "First day of week. `0` is Sunday, `1` is Monday.",
+				Computed:            true,
+			},
+			"calendar_week_column_header": schema.StringAttribute{
+				MarkdownDescription: "Calendar week column header.",
+				Computed:            true,
+			},
+			"short_date_format": schema.StringAttribute{
+				MarkdownDescription: "Short date format.",
+				Computed:            true,
+			},
+			"long_date_format": schema.StringAttribute{
+				MarkdownDescription: "Long date format.",
+				Computed:            true,
+			},
+			"time_format": schema.StringAttribute{
+				MarkdownDescription: "Time format.",
+				Computed:            true,
+			},
+			"show_relative_dates": schema.BoolAttribute{
+				MarkdownDescription: "Show relative dates flag.",
+				Computed:            true,
+			},
+			"enable_color_impaired_mode": schema.BoolAttribute{
+				MarkdownDescription: "Enable color impaired mode flag.",
+				Computed:            true,
+			},
+			"ui_language": schema.StringAttribute{
+				MarkdownDescription: "UI language.",
+				Computed:            true,
+			},
+			"theme": schema.StringAttribute{
+				MarkdownDescription: "Theme.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *UIConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.data = dataSourceConfigure(ctx, req, resp)
+}
+
+func (d *UIConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the instance from config
+	var name types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(instanceAttribute), &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := d.data.instance(name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(instanceAttribute), helpers.UnknownInstance, err.Error())
+
+		return
+	}
+
+	// Get UI config current value
+	response, _, err := instance.Client.UiConfigAPI.GetUiConfig(instance.Auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, uiConfigDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+uiConfigDataSourceName)
+
+	state := UIConfig{Instance: name}
+	state.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUIConfigResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccUIConfigResourceConfig("dark") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccUIConfigResourceConfig("dark"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_ui_config.test", "theme", "dark"),
+					resource.TestCheckResourceAttrSet("prowlarr_ui_config.test", "id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccUIConfigResourceConfig("dark") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccUIConfigResourceConfig("auto"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_ui_config.test", "theme", "auto"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "prowlarr_ui_config.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccUIConfigResourceConfig(theme string) string {
+	return fmt.Sprintf(`
+	resource "prowlarr_ui_config" "test" {
+		first_day_of_week = 0
+		calendar_week_column_header = "ddd M/D"
+		short_date_format = "MMM D YYYY"
+		long_date_format = "dddd, MMMM D YYYY"
+		time_format = "h(:mm)a"
+		show_relative_dates = true
+		enable_color_impaired_mode = false
+		ui_language = "en"
+		theme = "%s"
+	}`, theme)
+}
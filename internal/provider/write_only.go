@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const writeOnlySuffix = "_wo"
+
+// writeOnlyAttribute returns the write only alternative of a sensitive attribute.
+func writeOnlyAttribute(attribute, description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("%s Write only alternative to `%s`, it is never stored in state. Requires Terraform 1.11 or later.", description, attribute),
+		Optional:            true,
+		Sensitive:           true,
+		WriteOnly:           true,
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(path.MatchRoot(attribute)),
+		},
+	}
+}
+
+// writeOnlyVersionAttribute returns the attribute used to trigger the update of a write only attribute.
+func writeOnlyVersionAttribute(attribute string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: fmt.Sprintf("Version of `%s`, change it to send a new value to Prowlarr.", attribute),
+		Optional:            true,
+	}
+}
+
+// readWriteOnlyFields sets the write only attributes into the API fields.
+// Write only values are not part of plan and state, so they are read from the configuration.
+func readWriteOnlyFields(ctx context.Context, config tfsdk.Config, fields []prowlarr.Field, attributes map[string]string, diags *diag.Diagnostics) []prowlarr.Field {
+	values := make(map[string]types.String, len(attributes))
+
+	for attribute, name := range attributes {
+		var value types.String
+
+		diags.Append(config.GetAttribute(ctx, path.Root(attribute), &value)...)
+		values[name] = value
+	}
+
+	return helpers.SetWriteOnlyFields(fields, values)
+}